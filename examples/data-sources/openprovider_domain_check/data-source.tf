@@ -0,0 +1,19 @@
+data "openprovider_domain_check" "example" {
+  domains = ["example.com", "example.co.uk"]
+}
+
+resource "openprovider_domain" "example" {
+  domain       = "example.com"
+  owner_handle = "XX123456-XX"
+
+  lifecycle {
+    precondition {
+      condition     = data.openprovider_domain_check.example.results["example.com"].is_available
+      error_message = "example.com is not available for registration."
+    }
+    precondition {
+      condition     = !data.openprovider_domain_check.example.results["example.com"].is_premium
+      error_message = "example.com is a premium domain."
+    }
+  }
+}
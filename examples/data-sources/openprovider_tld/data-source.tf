@@ -0,0 +1,26 @@
+variable "registration_years" {
+  type    = number
+  default = 2
+}
+
+data "openprovider_tld" "us" {
+  name = "us"
+}
+
+resource "openprovider_domain" "example" {
+  domain       = "example.us"
+  owner_handle = "XX123456-XX"
+  period       = var.registration_years
+
+  additional_data = {
+    nexus_category      = "C12"
+    application_purpose = "P1"
+  }
+
+  lifecycle {
+    precondition {
+      condition     = contains(data.openprovider_tld.us.periods, var.registration_years)
+      error_message = ".us domains cannot be registered for ${var.registration_years} years."
+    }
+  }
+}
@@ -0,0 +1,13 @@
+# Country-code extensions that support DNSSEC and two-year registrations
+data "openprovider_tlds" "signed" {
+  type           = "ccTLD"
+  dnssec_allowed = true
+  period         = 2
+  with_price     = true
+}
+
+output "signed_tld_prices" {
+  value = {
+    for tld in data.openprovider_tlds.signed.tlds : tld.name => "${tld.registration_price} ${tld.currency}"
+  }
+}
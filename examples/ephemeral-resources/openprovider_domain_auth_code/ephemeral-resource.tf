@@ -0,0 +1,21 @@
+# Unlock the domain before moving it to another registrar
+resource "openprovider_domain" "example" {
+  domain       = "example.com"
+  owner_handle = "owner123"
+  is_locked    = false
+}
+
+ephemeral "openprovider_domain_auth_code" "example" {
+  domain = openprovider_domain.example.domain
+}
+
+# Hand the auth code over through a secret, without storing it in Terraform state
+resource "aws_secretsmanager_secret" "auth_code" {
+  name = "example.com-auth-code"
+}
+
+resource "aws_secretsmanager_secret_version" "auth_code" {
+  secret_id                = aws_secretsmanager_secret.auth_code.id
+  secret_string_wo         = ephemeral.openprovider_domain_auth_code.example.auth_code
+  secret_string_wo_version = 1
+}
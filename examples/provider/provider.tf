@@ -10,4 +10,7 @@ terraform {
 provider "openprovider" {
   username = var.openprovider_username
   password = var.openprovider_password
+
+  # Required to register domains and order certificates
+  allow_billable_operations = "production"
 }
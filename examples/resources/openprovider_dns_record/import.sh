@@ -0,0 +1,5 @@
+# Import a record set with a single value by zone, name and type
+terraform import openprovider_dns_record.mx "example.com/@/MX"
+
+# Import one value of a multi-value record set
+terraform import openprovider_dns_record.www_2 "example.com/www/A/192.0.2.2"
@@ -0,0 +1,4 @@
+import {
+  to = openprovider_dns_record.www_2
+  id = "example.com/www/A/192.0.2.2"
+}
@@ -0,0 +1,14 @@
+# Two values of the same round-robin record set
+resource "openprovider_dns_record" "www_1" {
+  zone_name = "example.com"
+  name      = "www"
+  type      = "A"
+  value     = "192.0.2.1"
+}
+
+resource "openprovider_dns_record" "www_2" {
+  zone_name = "example.com"
+  name      = "www"
+  type      = "A"
+  value     = "192.0.2.2"
+}
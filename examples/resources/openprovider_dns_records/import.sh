@@ -0,0 +1,2 @@
+# Import every record of a zone by zone name
+terraform import openprovider_dns_records.example "example.com"
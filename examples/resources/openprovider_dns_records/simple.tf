@@ -0,0 +1,25 @@
+resource "openprovider_dns_records" "example" {
+  zone_name = "example.com"
+
+  records = [
+    {
+      name  = "www"
+      type  = "A"
+      value = "192.0.2.1"
+    },
+    {
+      name  = "www"
+      type  = "A"
+      value = "192.0.2.2"
+    },
+    {
+      name     = "@"
+      type     = "MX"
+      value    = "mail.example.com"
+      priority = 10
+      ttl      = 300
+    },
+  ]
+
+  allow_deletion = true
+}
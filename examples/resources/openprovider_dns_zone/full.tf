@@ -0,0 +1,9 @@
+resource "openprovider_dns_zone" "example" {
+  zone_name     = "example.com"
+  template_name = "default"
+  premium_dns   = true
+  dnssec        = true
+
+  # Allow terraform destroy to delete the zone and all of its records
+  allow_deletion = true
+}
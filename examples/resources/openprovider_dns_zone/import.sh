@@ -0,0 +1,2 @@
+# Import by zone name
+terraform import openprovider_dns_zone.example "example.com"
@@ -0,0 +1,10 @@
+resource "openprovider_dns_zone" "example" {
+  zone_name = "example.com"
+}
+
+resource "openprovider_dns_record" "www" {
+  zone_name = openprovider_dns_zone.example.zone_name
+  name      = "www"
+  type      = "A"
+  value     = "192.0.2.1"
+}
@@ -0,0 +1,5 @@
+resource "openprovider_dns_zone" "secondary" {
+  zone_name = "example.net"
+  type      = "slave"
+  master_ip = "192.0.2.53"
+}
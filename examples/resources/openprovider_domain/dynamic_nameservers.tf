@@ -13,8 +13,8 @@ variable "nameservers" {
   ]
 
   validation {
-    condition     = length(var.nameservers) >= 2
-    error_message = "At least two nameservers are required."
+    condition     = length(var.nameservers) >= 2 && length(var.nameservers) <= 13
+    error_message = "Between 2 and 13 nameservers are required."
   }
 }
 
@@ -22,10 +22,5 @@ resource "openprovider_domain" "domain" {
   domain       = var.domain_name
   owner_handle = "owner123"
 
-  dynamic "nameserver" {
-    for_each = var.nameservers
-    content {
-      hostname = nameserver.value
-    }
-  }
+  nameservers = [for hostname in var.nameservers : { name = hostname }]
 }
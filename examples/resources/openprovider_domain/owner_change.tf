@@ -0,0 +1,11 @@
+# Hand a .com domain over to a new owner; the domain cannot be transferred to
+# another registrar for 60 days afterwards
+resource "openprovider_domain" "example" {
+  domain                   = "example.com"
+  owner_handle             = "newowner123"
+  accept_owner_change_lock = true
+
+  timeouts {
+    update = "24h"
+  }
+}
@@ -0,0 +1,14 @@
+# Register a premium domain, accepting at most 300 EUR for the registration
+resource "openprovider_domain" "premium" {
+  domain       = "premium.shop"
+  owner_handle = "owner123"
+
+  accept_premium_price = {
+    amount   = 300
+    currency = "EUR"
+  }
+}
+
+output "premium_price" {
+  value = openprovider_domain.premium.premium_price
+}
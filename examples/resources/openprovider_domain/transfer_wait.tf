@@ -0,0 +1,17 @@
+# Wait until the transfer has completed before dependent resources run
+variable "auth_code" {
+  type        = string
+  sensitive   = true
+  description = "The authorization code from your current registrar"
+}
+
+resource "openprovider_domain" "transferred" {
+  domain            = "example.com"
+  auth_code         = var.auth_code
+  owner_handle      = "owner123"
+  wait_for_transfer = true
+
+  timeouts {
+    create = "2h"
+  }
+}
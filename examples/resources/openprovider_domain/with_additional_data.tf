@@ -0,0 +1,21 @@
+# .us domains require the registrant's nexus category and the intended use
+resource "openprovider_domain" "us" {
+  domain       = "example.us"
+  owner_handle = "owner123"
+
+  additional_data = {
+    nexus_category      = "C12"
+    application_purpose = "P1"
+  }
+}
+
+# .es domains require the registrant's identity document
+resource "openprovider_domain" "es" {
+  domain       = "example.es"
+  owner_handle = "owner123"
+
+  additional_data = {
+    id_type   = "nif"
+    id_number = "12345678Z"
+  }
+}
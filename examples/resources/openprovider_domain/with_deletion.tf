@@ -0,0 +1,8 @@
+resource "openprovider_domain" "example" {
+  domain       = "example.com"
+  owner_handle = "owner123"
+
+  # Let the domain lapse at its expiration date when the resource is destroyed
+  allow_deletion = true
+  deletion_mode  = "delete_at_expiry"
+}
@@ -0,0 +1,22 @@
+# Delegate the domain to nameservers hosted elsewhere, e.g. Route 53
+resource "aws_route53_zone" "example" {
+  name = "example.com"
+}
+
+resource "openprovider_domain" "example" {
+  domain       = "example.com"
+  owner_handle = "owner123"
+
+  nameservers = [for ns in aws_route53_zone.example.name_servers : { name = ns }]
+}
+
+# Nameservers within the domain itself need glue addresses
+resource "openprovider_domain" "self_hosted" {
+  domain       = "example.org"
+  owner_handle = "owner123"
+
+  nameservers = [
+    { name = "ns1.example.org", ip = "192.0.2.1", ip6 = "2001:db8::1" },
+    { name = "ns2.example.org", ip = "192.0.2.2" },
+  ]
+}
@@ -0,0 +1,19 @@
+variable "auth_code_handover" {
+  description = "Change this value to generate a new auth code"
+  type        = string
+  default     = "2026-10"
+}
+
+# Generate a new auth code whenever auth_code_handover changes
+resource "openprovider_domain_auth_code_reset" "example" {
+  domain = "example.com"
+
+  triggers = {
+    handover = var.auth_code_handover
+  }
+}
+
+# Read the new code once the reset has been applied
+ephemeral "openprovider_domain_auth_code" "example" {
+  domain = openprovider_domain_auth_code_reset.example.domain
+}
@@ -0,0 +1,15 @@
+resource "openprovider_domain" "example" {
+  domain       = "example.com"
+  owner_handle = "XX123456-XX"
+  autorenew    = false
+}
+
+# Keep example.com registered until at least 2030
+resource "openprovider_domain_renewal" "example" {
+  domain             = openprovider_domain.example.domain
+  target_expiry_year = 2030
+}
+
+output "renewal_quote" {
+  value = "${openprovider_domain_renewal.example.quoted_price} ${openprovider_domain_renewal.example.currency}"
+}
@@ -0,0 +1,2 @@
+# Import by numeric order ID
+terraform import openprovider_ssl_order.example 123456
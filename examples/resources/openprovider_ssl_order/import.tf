@@ -0,0 +1,4 @@
+import {
+  to = openprovider_ssl_order.example
+  id = "123456"
+}
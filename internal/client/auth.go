@@ -0,0 +1,138 @@
+// Package client provides a client for interacting with the OpenProvider API.
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	// tokenTTL is how long a token obtained through login is assumed to be valid.
+	// OpenProvider does not return an expiry, so this errs on the short side.
+	tokenTTL = 24 * time.Hour
+	// tokenRefreshWindow is how long before expiry a background refresh is started.
+	tokenRefreshWindow = 10 * time.Minute
+)
+
+// loginFunc obtains a fresh API token.
+type loginFunc func(ctx context.Context) (string, error)
+
+// loginCall tracks a login in progress so that concurrent callers can share its result.
+type loginCall struct {
+	done  chan struct{}
+	token string
+	err   error
+}
+
+// authenticator manages the API token lifecycle and is safe for concurrent use.
+//
+// At most one login runs at a time: callers that need a token while a login is in
+// flight block until it finishes and share its result. Tokens approaching expiry are
+// refreshed in the background while callers keep using the current token.
+type authenticator struct {
+	login loginFunc
+	now   func() time.Time
+
+	mu      sync.Mutex
+	token   string
+	expiry  time.Time
+	pending *loginCall
+}
+
+// newAuthenticator returns an authenticator seeded with an optional static token.
+// A nil login function means the token can never be refreshed.
+func newAuthenticator(token string, login loginFunc) *authenticator {
+	return &authenticator{
+		login: login,
+		now:   time.Now,
+		token: token,
+	}
+}
+
+// canLogin reports whether the authenticator is able to obtain new tokens.
+func (a *authenticator) canLogin() bool {
+	return a.login != nil
+}
+
+// current returns the token currently held, without triggering a login.
+func (a *authenticator) current() string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.token
+}
+
+// Token returns a valid token, logging in if there is none or it has expired.
+func (a *authenticator) Token(ctx context.Context) (string, error) {
+	a.mu.Lock()
+	if a.login == nil {
+		token := a.token
+		a.mu.Unlock()
+		return token, nil
+	}
+
+	now := a.now()
+	expired := a.token == "" || (!a.expiry.IsZero() && !now.Before(a.expiry))
+	if !expired {
+		token := a.token
+		if !a.expiry.IsZero() && !now.Before(a.expiry.Add(-tokenRefreshWindow)) {
+			// Refresh proactively; callers keep using the current token meanwhile.
+			a.startLoginLocked(ctx)
+		}
+		a.mu.Unlock()
+		return token, nil
+	}
+
+	call := a.startLoginLocked(ctx)
+	a.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.token, call.err
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+// invalidate discards token if it is still the current one, so that the next call
+// to Token logs in again. Tokens that were already replaced are ignored, which
+// keeps a burst of 401 responses from triggering more than one login.
+func (a *authenticator) invalidate(token string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.token == token {
+		a.token = ""
+		a.expiry = time.Time{}
+	}
+}
+
+// startLoginLocked starts a login unless one is already running and returns the
+// call to wait on. a.mu must be held.
+func (a *authenticator) startLoginLocked(ctx context.Context) *loginCall {
+	if a.pending != nil {
+		return a.pending
+	}
+
+	call := &loginCall{done: make(chan struct{})}
+	a.pending = call
+
+	// The login is shared by every waiter, so it must not be cancelled when the
+	// caller that happened to start it goes away.
+	loginCtx := context.WithoutCancel(ctx)
+	go func() {
+		token, err := a.login(loginCtx)
+
+		a.mu.Lock()
+		if err == nil {
+			a.token = token
+			a.expiry = a.now().Add(tokenTTL)
+		}
+		a.pending = nil
+		a.mu.Unlock()
+
+		call.token, call.err = token, err
+		close(call.done)
+	}()
+
+	return call
+}
@@ -0,0 +1,231 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// tokenServer is a stub OpenProvider API that issues numbered tokens and only
+// accepts the most recently issued one.
+type tokenServer struct {
+	logins  atomic.Int32
+	current atomic.Value
+	// loginDelay widens the window in which concurrent callers could race.
+	loginDelay time.Duration
+}
+
+func (s *tokenServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if strings.HasSuffix(r.URL.Path, "/v1beta/auth/login") {
+		time.Sleep(s.loginDelay)
+		token := fmt.Sprintf("token-%d", s.logins.Add(1))
+		s.current.Store(token)
+		_, _ = fmt.Fprintf(w, `{"code": 0, "data": {"token": %q}}`, token)
+		return
+	}
+
+	current, _ := s.current.Load().(string)
+	if r.Header.Get("Authorization") != "Bearer "+current {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"code": 196, "desc": "Authentication/Authorization Failed"}`))
+		return
+	}
+	_, _ = w.Write([]byte(`{"code": 0, "data": {}}`))
+}
+
+// expire makes the server reject every token issued so far.
+func (s *tokenServer) expire() {
+	s.current.Store("expired")
+}
+
+func hammer(t *testing.T, c *Client, url string, goroutines, requests int) {
+	t.Helper()
+
+	var wg sync.WaitGroup
+	errs := make(chan error, goroutines*requests)
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < requests; j++ {
+				req, _ := http.NewRequest("GET", url+"/v1beta/domains", nil)
+				resp, err := c.Do(context.Background(), req)
+				if err != nil {
+					errs <- err
+					continue
+				}
+				_ = resp.Body.Close()
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestDoConcurrentRequestsShareOneLogin(t *testing.T) {
+	stub := &tokenServer{loginDelay: 20 * time.Millisecond}
+	server := httptest.NewServer(stub)
+	defer server.Close()
+
+	c := NewClient(Config{
+		BaseURL:    server.URL,
+		Username:   "testuser",
+		Password:   "testpass",
+		Retry:      &RetryPolicy{},
+		HTTPClient: server.Client(),
+	})
+
+	hammer(t, c, server.URL, 50, 10)
+
+	if got := stub.logins.Load(); got != 1 {
+		t.Errorf("Expected exactly 1 login, got %d", got)
+	}
+	if c.Token() != "token-1" {
+		t.Errorf("Expected token-1, got %q", c.Token())
+	}
+}
+
+func TestDoConcurrentRequestsReloginOncePerExpiry(t *testing.T) {
+	stub := &tokenServer{loginDelay: 20 * time.Millisecond}
+	server := httptest.NewServer(stub)
+	defer server.Close()
+
+	c := NewClient(Config{
+		BaseURL:    server.URL,
+		Username:   "testuser",
+		Password:   "testpass",
+		Retry:      &RetryPolicy{},
+		HTTPClient: server.Client(),
+	})
+
+	hammer(t, c, server.URL, 20, 5)
+	stub.expire()
+	hammer(t, c, server.URL, 50, 10)
+
+	if got := stub.logins.Load(); got != 2 {
+		t.Errorf("Expected exactly 2 logins (initial + one after expiry), got %d", got)
+	}
+	if c.Token() != "token-2" {
+		t.Errorf("Expected token-2, got %q", c.Token())
+	}
+}
+
+func TestAuthenticatorWaitersShareLogin(t *testing.T) {
+	release := make(chan struct{})
+	var calls atomic.Int32
+	a := newAuthenticator("", func(context.Context) (string, error) {
+		calls.Add(1)
+		<-release
+		return "shared-token", nil
+	})
+
+	var wg sync.WaitGroup
+	tokens := make(chan string, 20)
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			token, err := a.Token(context.Background())
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+			tokens <- token
+		}()
+	}
+
+	// Give every goroutine a chance to block on the pending login.
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	close(tokens)
+
+	for token := range tokens {
+		if token != "shared-token" {
+			t.Errorf("Expected shared-token, got %q", token)
+		}
+	}
+	if calls.Load() != 1 {
+		t.Errorf("Expected exactly 1 login, got %d", calls.Load())
+	}
+}
+
+func TestAuthenticatorRefreshesBeforeExpiry(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	var mu sync.Mutex
+	clock := func() time.Time {
+		mu.Lock()
+		defer mu.Unlock()
+		return now
+	}
+	advance := func(d time.Duration) {
+		mu.Lock()
+		defer mu.Unlock()
+		now = now.Add(d)
+	}
+
+	var calls atomic.Int32
+	refreshed := make(chan struct{}, 1)
+	a := newAuthenticator("", func(context.Context) (string, error) {
+		n := calls.Add(1)
+		if n > 1 {
+			defer func() { refreshed <- struct{}{} }()
+		}
+		return fmt.Sprintf("token-%d", n), nil
+	})
+	a.now = clock
+
+	token, err := a.Token(context.Background())
+	if err != nil || token != "token-1" {
+		t.Fatalf("Expected token-1, got %q (%v)", token, err)
+	}
+
+	// Inside the refresh window the current token is still handed out while a
+	// background refresh replaces it.
+	advance(tokenTTL - tokenRefreshWindow/2)
+	token, err = a.Token(context.Background())
+	if err != nil || token != "token-1" {
+		t.Fatalf("Expected token-1 during refresh window, got %q (%v)", token, err)
+	}
+
+	select {
+	case <-refreshed:
+	case <-time.After(time.Second):
+		t.Fatal("Expected a proactive refresh")
+	}
+
+	// Wait for the refresh goroutine to publish the new token.
+	deadline := time.Now().Add(time.Second)
+	for a.current() != "token-2" && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if a.current() != "token-2" {
+		t.Errorf("Expected token-2 after refresh, got %q", a.current())
+	}
+}
+
+func TestAuthenticatorIgnoresStaleInvalidation(t *testing.T) {
+	a := newAuthenticator("token-2", func(context.Context) (string, error) {
+		return "token-3", nil
+	})
+
+	a.invalidate("token-1")
+	if a.current() != "token-2" {
+		t.Errorf("Expected stale invalidation to be ignored, got %q", a.current())
+	}
+
+	a.invalidate("token-2")
+	token, err := a.Token(context.Background())
+	if err != nil || token != "token-3" {
+		t.Errorf("Expected token-3 after invalidation, got %q (%v)", token, err)
+	}
+}
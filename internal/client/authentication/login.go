@@ -3,6 +3,7 @@ package authentication
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -22,7 +23,8 @@ type LoginRequest struct {
 
 // LoginResponse represents a response from the authentication endpoint.
 type LoginResponse struct {
-	Code int `json:"code"`
+	Code int    `json:"code"`
+	Desc string `json:"desc"`
 	Data struct {
 		Token      string `json:"token"`
 		ResellerID int    `json:"reseller_id"`
@@ -30,7 +32,7 @@ type LoginResponse struct {
 }
 
 // Login authenticates a user and returns a token.
-func Login(c HTTPClient, baseURL, ipAddress, username, password string) (*string, error) {
+func Login(ctx context.Context, c HTTPClient, baseURL, ipAddress, username, password string) (*string, error) {
 	request := LoginRequest{
 		IPAddress: "0.0.0.0",
 		Username:  username,
@@ -45,7 +47,7 @@ func Login(c HTTPClient, baseURL, ipAddress, username, password string) (*string
 	}
 
 	path := "/v1beta/auth/login"
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s%s", baseURL, path), bytes.NewBuffer(body))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s%s", baseURL, path), bytes.NewBuffer(body))
 	if err != nil {
 		return nil, err
 	}
@@ -61,8 +63,17 @@ func Login(c HTTPClient, baseURL, ipAddress, username, password string) (*string
 	}()
 
 	var results LoginResponse
-	if err := json.NewDecoder(resp.Body).Decode(&results); err != nil {
-		return nil, err
+	decodeErr := json.NewDecoder(resp.Body).Decode(&results)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 || (decodeErr == nil && results.Code != 0) {
+		desc := results.Desc
+		if desc == "" {
+			desc = http.StatusText(resp.StatusCode)
+		}
+		return nil, fmt.Errorf("login failed: status %d, code %d: %s", resp.StatusCode, results.Code, desc)
+	}
+	if decodeErr != nil {
+		return nil, decodeErr
 	}
 	return &results.Data.Token, nil
 }
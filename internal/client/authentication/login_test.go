@@ -2,6 +2,7 @@
 package authentication_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/charpand/terraform-provider-openprovider/internal/client/authentication"
@@ -11,7 +12,7 @@ import (
 func TestLogin(t *testing.T) {
 	apiClient := testutils.SetupTestClient()
 
-	token, err := authentication.Login(apiClient.HTTPClient, apiClient.BaseURL, "127.0.0.1", "test", "test")
+	token, err := authentication.Login(context.Background(), apiClient.HTTPClient, apiClient.BaseURL, "127.0.0.1", "test", "test")
 
 	if err != nil {
 		t.Fatalf("Expected no error, got %v", err)
@@ -0,0 +1,187 @@
+// Package client provides a client for interacting with the OpenProvider API.
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// sendFunc performs a request without consulting the cache.
+type sendFunc func(ctx context.Context, req *http.Request) (*http.Response, error)
+
+// cachedResponse is a successful GET response kept in memory.
+type cachedResponse struct {
+	resp    *http.Response
+	body    []byte
+	expires time.Time
+}
+
+// cacheCall tracks a GET in flight so that identical concurrent requests share it.
+type cacheCall struct {
+	done chan struct{}
+	resp *http.Response
+	body []byte
+	err  error
+}
+
+// responseCache is a short-lived read cache for GET requests, keyed by path and query.
+// Do consults it when Config.CacheTTL is set.
+//
+// Concurrent identical GETs are coalesced into a single API call. Any mutating request
+// invalidates cached entries for its own path, its ancestors and its descendants, so that
+// e.g. a PUT to /v1beta/dns/zones/example.com/records drops the cached record list, the
+// zone and the zone list. Read-only endpoints that are not GETs, listed in
+// readOnlyEndpoints, leave the cache alone.
+type responseCache struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu         sync.Mutex
+	entries    map[string]*cachedResponse
+	inflight   map[string]*cacheCall
+	generation uint64
+}
+
+// readOnlyEndpoints lists the endpoints, as "METHOD /path", that only read data even
+// though they are not GETs, such as the domain availability check that runs on every
+// plan of a domain. Requests to them do not invalidate the cache.
+var readOnlyEndpoints = map[string]bool{
+	"POST /v1beta/domains/check": true,
+}
+
+// mutates reports whether req may change data on the server.
+func mutates(req *http.Request) bool {
+	return req.Method != http.MethodGet && !readOnlyEndpoints[req.Method+" "+strings.TrimSuffix(req.URL.Path, "/")]
+}
+
+// newResponseCache returns a cache whose entries live for ttl.
+func newResponseCache(ttl time.Duration) *responseCache {
+	return &responseCache{
+		ttl:      ttl,
+		now:      time.Now,
+		entries:  make(map[string]*cachedResponse),
+		inflight: make(map[string]*cacheCall),
+	}
+}
+
+// cacheKey identifies a GET request by its path and query.
+func cacheKey(req *http.Request) string {
+	return req.URL.Path + "?" + req.URL.RawQuery
+}
+
+// get serves req from the cache, joins an identical request in flight or sends it.
+func (rc *responseCache) get(ctx context.Context, req *http.Request, send sendFunc) (*http.Response, error) {
+	key := cacheKey(req)
+
+	rc.mu.Lock()
+	if entry, ok := rc.entries[key]; ok {
+		if rc.now().Before(entry.expires) {
+			rc.mu.Unlock()
+			return cloneResponse(entry.resp, entry.body, req), nil
+		}
+		delete(rc.entries, key)
+	}
+
+	if call, ok := rc.inflight[key]; ok {
+		rc.mu.Unlock()
+		select {
+		case <-call.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		if call.err != nil {
+			// The shared request was cancelled on behalf of another caller;
+			// this caller is still interested, so it sends its own.
+			if isContextError(call.err) && ctx.Err() == nil {
+				return send(ctx, req)
+			}
+			return nil, call.err
+		}
+		return cloneResponse(call.resp, call.body, req), nil
+	}
+
+	call := &cacheCall{done: make(chan struct{})}
+	rc.inflight[key] = call
+	generation := rc.generation
+	rc.mu.Unlock()
+
+	resp, err := send(ctx, req)
+	var body []byte
+	if err == nil {
+		body, err = io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+	}
+
+	rc.mu.Lock()
+	delete(rc.inflight, key)
+	// Skip storing if a mutation invalidated the cache while the request was in flight,
+	// as the response may predate it.
+	if err == nil && generation == rc.generation {
+		rc.sweepLocked()
+		rc.entries[key] = &cachedResponse{resp: resp, body: body, expires: rc.now().Add(rc.ttl)}
+	}
+	rc.mu.Unlock()
+
+	call.resp, call.body, call.err = resp, body, err
+	close(call.done)
+
+	if err != nil {
+		return nil, err
+	}
+	return cloneResponse(resp, body, req), nil
+}
+
+// invalidate drops cached entries related to path: the path itself, its ancestors
+// and its descendants.
+func (rc *responseCache) invalidate(path string) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	rc.generation++
+	for key := range rc.entries {
+		cachedPath, _, _ := strings.Cut(key, "?")
+		if relatedPaths(cachedPath, path) {
+			delete(rc.entries, key)
+		}
+	}
+}
+
+// sweepLocked removes expired entries. rc.mu must be held.
+func (rc *responseCache) sweepLocked() {
+	now := rc.now()
+	for key, entry := range rc.entries {
+		if !now.Before(entry.expires) {
+			delete(rc.entries, key)
+		}
+	}
+}
+
+// relatedPaths reports whether one path equals or is a segment-wise prefix of the other.
+func relatedPaths(a, b string) bool {
+	a, b = strings.TrimSuffix(a, "/"), strings.TrimSuffix(b, "/")
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	return a == b || strings.HasPrefix(b, a+"/")
+}
+
+// cloneResponse returns a copy of resp with its own reader over body, bound to req.
+func cloneResponse(resp *http.Response, body []byte, req *http.Request) *http.Response {
+	clone := *resp
+	clone.Header = resp.Header.Clone()
+	clone.Body = io.NopCloser(bytes.NewReader(body))
+	clone.ContentLength = int64(len(body))
+	clone.Request = req
+	return &clone
+}
+
+// isContextError reports whether err stems from a cancelled or expired context.
+func isContextError(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
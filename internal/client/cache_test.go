@@ -0,0 +1,245 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// countingServer counts requests per method and path and answers every GET with
+// a body naming the request number.
+type countingServer struct {
+	mu     sync.Mutex
+	counts map[string]int
+	total  atomic.Int32
+	delay  time.Duration
+	status int
+}
+
+func (s *countingServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	n := s.total.Add(1)
+	s.mu.Lock()
+	s.counts[r.Method+" "+r.URL.Path]++
+	s.mu.Unlock()
+
+	time.Sleep(s.delay)
+	if s.status != 0 {
+		w.WriteHeader(s.status)
+		_, _ = w.Write([]byte(`{"code": 1, "desc": "failure"}`))
+		return
+	}
+	_, _ = fmt.Fprintf(w, `{"code": 0, "data": {"n": %d}}`, n)
+}
+
+func (s *countingServer) count(key string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.counts[key]
+}
+
+func newCachingClient(t *testing.T, stub *countingServer) (*Client, string) {
+	t.Helper()
+	stub.counts = make(map[string]int)
+	server := httptest.NewServer(stub)
+	t.Cleanup(server.Close)
+
+	c := NewClient(Config{
+		BaseURL:    server.URL,
+		Token:      "valid-token",
+		Retry:      &RetryPolicy{},
+		CacheTTL:   time.Minute,
+		HTTPClient: server.Client(),
+	})
+	return c, server.URL
+}
+
+func doRequest(t *testing.T, c *Client, method, url string) string {
+	t.Helper()
+	req, _ := http.NewRequest(method, url, nil)
+	resp, err := c.Do(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	body, _ := io.ReadAll(resp.Body)
+	return string(body)
+}
+
+func TestCacheServesRepeatedGets(t *testing.T) {
+	stub := &countingServer{}
+	c, url := newCachingClient(t, stub)
+
+	first := doRequest(t, c, "GET", url+"/v1beta/dns/zones/example.com/records?limit=100&offset=0")
+	second := doRequest(t, c, "GET", url+"/v1beta/dns/zones/example.com/records?limit=100&offset=0")
+
+	if first != second {
+		t.Errorf("Expected identical cached bodies, got %q and %q", first, second)
+	}
+	if got := stub.count("GET /v1beta/dns/zones/example.com/records"); got != 1 {
+		t.Errorf("Expected 1 request, got %d", got)
+	}
+
+	// A different query is a different cache entry.
+	doRequest(t, c, "GET", url+"/v1beta/dns/zones/example.com/records?limit=100&offset=100")
+	if got := stub.count("GET /v1beta/dns/zones/example.com/records"); got != 2 {
+		t.Errorf("Expected 2 requests, got %d", got)
+	}
+}
+
+func TestCacheExpires(t *testing.T) {
+	stub := &countingServer{}
+	c, url := newCachingClient(t, stub)
+
+	now := time.Now()
+	c.cache.now = func() time.Time { return now }
+
+	doRequest(t, c, "GET", url+"/v1beta/domains")
+	now = now.Add(2 * time.Minute)
+	doRequest(t, c, "GET", url+"/v1beta/domains")
+
+	if got := stub.count("GET /v1beta/domains"); got != 2 {
+		t.Errorf("Expected expired entry to be refetched, got %d requests", got)
+	}
+}
+
+func TestCacheCoalescesConcurrentGets(t *testing.T) {
+	stub := &countingServer{delay: 50 * time.Millisecond}
+	c, url := newCachingClient(t, stub)
+
+	var wg sync.WaitGroup
+	bodies := make(chan string, 50)
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, _ := http.NewRequest("GET", url+"/v1beta/dns/zones/example.com/records", nil)
+			resp, err := c.Do(context.Background(), req)
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+				return
+			}
+			body, _ := io.ReadAll(resp.Body)
+			_ = resp.Body.Close()
+			bodies <- string(body)
+		}()
+	}
+	wg.Wait()
+	close(bodies)
+
+	if got := stub.count("GET /v1beta/dns/zones/example.com/records"); got != 1 {
+		t.Errorf("Expected 1 request, got %d", got)
+	}
+	var first string
+	for body := range bodies {
+		if first == "" {
+			first = body
+		}
+		if body != first || body == "" {
+			t.Errorf("Expected every caller to read the full shared body, got %q", body)
+		}
+	}
+}
+
+func TestCacheInvalidatesOnMutation(t *testing.T) {
+	stub := &countingServer{}
+	c, url := newCachingClient(t, stub)
+
+	paths := []string{
+		"/v1beta/dns/zones",
+		"/v1beta/dns/zones/example.com",
+		"/v1beta/dns/zones/example.com/records",
+		"/v1beta/dns/zones/other.com/records",
+		"/v1beta/domains",
+	}
+	for _, p := range paths {
+		doRequest(t, c, "GET", url+p)
+	}
+
+	doRequest(t, c, "PUT", url+"/v1beta/dns/zones/example.com")
+
+	for _, p := range paths {
+		doRequest(t, c, "GET", url+p)
+	}
+
+	expected := map[string]int{
+		"/v1beta/dns/zones":                     2,
+		"/v1beta/dns/zones/example.com":         2,
+		"/v1beta/dns/zones/example.com/records": 2,
+		"/v1beta/dns/zones/other.com/records":   1,
+		"/v1beta/domains":                       1,
+	}
+	for p, want := range expected {
+		if got := stub.count("GET " + p); got != want {
+			t.Errorf("%s: expected %d requests, got %d", p, want, got)
+		}
+	}
+}
+
+func TestCacheIgnoresReadOnlyPosts(t *testing.T) {
+	stub := &countingServer{}
+	c, url := newCachingClient(t, stub)
+
+	doRequest(t, c, "GET", url+"/v1beta/domains")
+	doRequest(t, c, "POST", url+"/v1beta/domains/check")
+	doRequest(t, c, "GET", url+"/v1beta/domains")
+	if got := stub.count("GET /v1beta/domains"); got != 1 {
+		t.Errorf("Expected the domain check to keep the cache, got %d requests", got)
+	}
+
+	doRequest(t, c, "POST", url+"/v1beta/domains/transfer")
+	doRequest(t, c, "GET", url+"/v1beta/domains")
+	if got := stub.count("GET /v1beta/domains"); got != 2 {
+		t.Errorf("Expected a transfer to invalidate the cache, got %d requests", got)
+	}
+}
+
+func TestCacheDoesNotStoreErrors(t *testing.T) {
+	stub := &countingServer{status: http.StatusNotFound}
+	c, url := newCachingClient(t, stub)
+
+	for i := 0; i < 2; i++ {
+		req, _ := http.NewRequest("GET", url+"/v1beta/domains/1", nil)
+		if _, err := c.Do(context.Background(), req); !IsNotFound(err) {
+			t.Fatalf("Expected not found error, got %v", err)
+		}
+	}
+
+	if got := stub.count("GET /v1beta/domains/1"); got != 2 {
+		t.Errorf("Expected errors not to be cached, got %d requests", got)
+	}
+}
+
+func TestCacheDisabledByDefault(t *testing.T) {
+	c := NewClient(Config{Token: "valid-token"})
+	if c.cache != nil {
+		t.Error("Expected the cache to be disabled without CacheTTL")
+	}
+}
+
+func TestRelatedPaths(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected bool
+	}{
+		{"/v1beta/domains", "/v1beta/domains", true},
+		{"/v1beta/domains", "/v1beta/domains/1", true},
+		{"/v1beta/domains/1", "/v1beta/domains", true},
+		{"/v1beta/domains/1", "/v1beta/domains/2", false},
+		{"/v1beta/domains", "/v1beta/domainsx", false},
+		{"/v1beta/dns/zones/a.com/", "/v1beta/dns/zones/a.com", true},
+	}
+
+	for _, tt := range tests {
+		if got := relatedPaths(tt.a, tt.b); got != tt.expected {
+			t.Errorf("relatedPaths(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.expected)
+		}
+	}
+}
@@ -2,6 +2,8 @@
 package client
 
 import (
+	"context"
+	"crypto/tls"
 	"fmt"
 	"net/http"
 	"time"
@@ -12,79 +14,239 @@ import (
 const (
 	// DefaultBaseURL -- root url for openprovider api
 	DefaultBaseURL = "https://api.openprovider.eu"
+
+	// DefaultTimeout is the timeout of a single HTTP request made by the default HTTP client.
+	DefaultTimeout = 30 * time.Second
 )
 
 // Config represents the configuration settings for a client, including the base API URL and an optional HTTP client.
 type Config struct {
+	// Environment selects the OpenProvider environment, EnvironmentProduction or
+	// EnvironmentSandbox. When empty, EnvironmentProduction is used. BaseURL, when
+	// set, takes precedence over the environment's root url. Errors from a sandbox
+	// client are prefixed with "[sandbox]" so they cannot be mistaken for
+	// production failures.
+	Environment string
+
+	// AllowBillableOperations names the environment in which CheckBillable permits
+	// billable operations. Billable operations are refused in any other environment.
+	AllowBillableOperations string
+
+	// DeferBillableCheck makes CheckBillable pass while AllowBillableOperations is
+	// not known yet, for instance when Terraform plans with a setting taken from
+	// another resource. The operation is checked again once the setting is known.
+	DeferBillableCheck bool
+
 	BaseURL  string
 	Username string
 	Password string
 	Token    string
 
+	// IPAddress is sent with login requests. OpenProvider accounts can restrict API
+	// access to whitelisted addresses; when empty, 0.0.0.0 is sent.
+	IPAddress string
+
+	// Timeout limits a single HTTP request made by the default HTTP client. When
+	// zero, DefaultTimeout is used. Ignored when HTTPClient is set.
+	Timeout time.Duration
+
+	// InsecureSkipVerify disables TLS certificate verification in the default HTTP
+	// client, e.g. for a local stub API with a self-signed certificate. Ignored when
+	// HTTPClient is set.
+	InsecureSkipVerify bool
+
+	// Retry overrides the retry policy for rate-limited and transient failures.
+	// When nil, DefaultRetryPolicy is used.
+	Retry *RetryPolicy
+
+	// PageSize is the number of items requested per page by list functions.
+	// When zero, DefaultPageSize is used.
+	PageSize int
+
+	// CacheTTL enables an in-memory cache for GET responses when greater than zero.
+	// Identical concurrent GETs are coalesced into one request and mutating requests
+	// invalidate cached responses for the paths they touch.
+	CacheTTL time.Duration
+
 	HTTPClient *http.Client
 }
 
 // Client represents a client for interacting with the OpenProvider API.
+// A Client is safe for concurrent use by multiple goroutines.
 type Client struct {
+	Environment             string
+	AllowBillableOperations string
+	DeferBillableCheck      bool
+
 	BaseURL  string
 	Username string
 	Password string
-	Token    string
+
+	IPAddress string
+
+	Retry RetryPolicy
+
+	PageSize int
 
 	HTTPClient *http.Client
+
+	auth  *authenticator
+	cache *responseCache
 }
 
 // NewClient creates a new client with the given configuration.
 func NewClient(config Config) *Client {
+	environment := config.Environment
+	if environment == "" {
+		environment = EnvironmentProduction
+	}
+
 	baseURL := config.BaseURL
+	if baseURL == "" {
+		baseURL = BaseURLForEnvironment(environment)
+	}
 	if baseURL == "" {
 		baseURL = DefaultBaseURL
 	}
 
 	httpClient := config.HTTPClient
 	if httpClient == nil {
+		timeout := config.Timeout
+		if timeout <= 0 {
+			timeout = DefaultTimeout
+		}
 		httpClient = &http.Client{
-			Timeout: time.Second * 30,
+			Timeout: timeout,
+		}
+		if config.InsecureSkipVerify {
+			transport := http.DefaultTransport.(*http.Transport).Clone()
+			transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+			httpClient.Transport = transport
 		}
 	}
 
-	return &Client{
+	retry := DefaultRetryPolicy()
+	if config.Retry != nil {
+		retry = *config.Retry
+	}
+
+	c := &Client{
+		Environment:             environment,
+		AllowBillableOperations: config.AllowBillableOperations,
+		DeferBillableCheck:      config.DeferBillableCheck,
+
 		BaseURL:    baseURL,
 		HTTPClient: httpClient,
 		Username:   config.Username,
 		Password:   config.Password,
-		Token:      config.Token,
+		IPAddress:  config.IPAddress,
+		Retry:      retry,
+		PageSize:   normalizePageSize(config.PageSize),
+	}
+
+	var login loginFunc
+	if config.Username != "" && config.Password != "" {
+		login = c.login
+	}
+	c.auth = newAuthenticator(config.Token, login)
+
+	if config.CacheTTL > 0 {
+		c.cache = newResponseCache(config.CacheTTL)
 	}
+
+	return c
+}
+
+// Token returns the API token currently held by the client, if any.
+func (c *Client) Token() string {
+	return c.auth.current()
+}
+
+// login obtains a new token using the client's credentials.
+func (c *Client) login(ctx context.Context) (string, error) {
+	token, err := authentication.Login(ctx, c.HTTPClient, c.BaseURL, c.IPAddress, c.Username, c.Password)
+	if err != nil {
+		return "", err
+	}
+	return *token, nil
 }
 
-// Do executes a request and returns the response. It handles authentication and retries once if the token is expired.
-func (c *Client) Do(req *http.Request) (*http.Response, error) {
-	if c.Token == "" && c.Username != "" && c.Password != "" {
-		token, err := authentication.Login(c.HTTPClient, c.BaseURL, "", c.Username, c.Password)
-		if err != nil {
-			return nil, fmt.Errorf("initial authentication failed: %w", err)
+// Do executes a request bound to ctx and returns the response. It logs in when
+// needed, sharing a single login between concurrent callers, and logs in again
+// once if the token has expired. Failures are retried according to c.Retry and
+// GETs may be served from the read cache. Failed responses are returned as an
+// *APIError with the body closed.
+func (c *Client) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	resp, err := c.do(ctx, req)
+	if err != nil && c.Environment == EnvironmentSandbox {
+		err = fmt.Errorf("[sandbox] %w", err)
+	}
+	return resp, err
+}
+
+// do dispatches req to the read cache or directly to the API.
+func (c *Client) do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	req = req.WithContext(ctx)
+
+	if c.cache != nil {
+		if req.Method == http.MethodGet {
+			return c.cache.get(ctx, req, c.send)
+		}
+		if mutates(req) {
+			// Invalidate even on failure: the server may have applied part of the change.
+			defer c.cache.invalidate(req.URL.Path)
 		}
-		c.Token = *token
 	}
 
-	req.Header.Set("Content-Type", "application/json")
-	if c.Token != "" {
-		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.Token))
+	return c.send(ctx, req)
+}
+
+// send performs req against the API, handling authentication and retries.
+func (c *Client) send(ctx context.Context, req *http.Request) (*http.Response, error) {
+	if err := makeRewindable(req); err != nil {
+		return nil, err
 	}
 
-	resp, err := c.HTTPClient.Do(req)
-	if err == nil && resp.StatusCode == http.StatusUnauthorized && c.Username != "" && c.Password != "" {
-		// Try to login and retry the request
-		token, err := authentication.Login(c.HTTPClient, c.BaseURL, "", c.Username, c.Password)
-		if err != nil {
-			return nil, fmt.Errorf("authentication failed: %w", err)
+	req.Header.Set("Content-Type", "application/json")
+
+	var resp *http.Response
+	var err error
+	reauthenticated := false
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 || reauthenticated {
+			if err := rewind(req); err != nil {
+				return nil, err
+			}
+		}
+
+		token, authErr := c.auth.Token(ctx)
+		if authErr != nil {
+			return nil, fmt.Errorf("authentication failed: %w", authErr)
+		}
+		if token != "" {
+			req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
 		}
-		c.Token = *token
 
-		// Update Authorization header and retry
-		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.Token))
 		resp, err = c.HTTPClient.Do(req)
-		if err != nil {
+		if err == nil && resp.StatusCode == http.StatusUnauthorized && !reauthenticated && c.auth.canLogin() {
+			_ = resp.Body.Close()
+
+			// Drop the rejected token and retry the request with a fresh one
+			c.auth.invalidate(token)
+			reauthenticated = true
+			attempt--
+			continue
+		}
+
+		if !c.Retry.shouldRetry(ctx, attempt, req, resp, err) {
+			break
+		}
+
+		wait := c.Retry.backoff(attempt, resp)
+		if resp != nil {
+			_ = resp.Body.Close()
+		}
+		if err := sleep(ctx, wait); err != nil {
 			return nil, err
 		}
 	}
@@ -93,8 +255,8 @@ func (c *Client) Do(req *http.Request) (*http.Response, error) {
 		return nil, err
 	}
 
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return resp, fmt.Errorf("api error: status %d", resp.StatusCode)
+	if err := checkResponse(resp); err != nil {
+		return nil, err
 	}
 
 	return resp, nil
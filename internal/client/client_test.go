@@ -1,10 +1,15 @@
 package client
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 )
 
 type mockAuthTransport struct {
@@ -85,7 +90,7 @@ func TestNewClient(t *testing.T) {
 		})
 
 		req, _ := http.NewRequest("GET", "http://example.com/test", nil)
-		resp, err := client.Do(req)
+		resp, err := client.Do(context.Background(), req)
 
 		if err != nil {
 			t.Fatalf("Unexpected error: %v", err)
@@ -96,8 +101,8 @@ func TestNewClient(t *testing.T) {
 		if !transport.loginCalled {
 			t.Error("Expected Login to be called, but it wasn't")
 		}
-		if client.Token != "new-token" {
-			t.Errorf("Expected token to be updated to 'new-token', got '%s'", client.Token)
+		if client.Token() != "new-token" {
+			t.Errorf("Expected token to be updated to 'new-token', got '%s'", client.Token())
 		}
 	})
 
@@ -112,7 +117,7 @@ func TestNewClient(t *testing.T) {
 		})
 
 		req, _ := http.NewRequest("GET", "http://example.com/test", nil)
-		resp, err := client.Do(req)
+		resp, err := client.Do(context.Background(), req)
 
 		if err != nil {
 			t.Fatalf("Unexpected error: %v", err)
@@ -123,8 +128,116 @@ func TestNewClient(t *testing.T) {
 		if !transport.loginCalled {
 			t.Error("Expected Login to be called on 401, but it wasn't")
 		}
-		if client.Token != "new-token" {
-			t.Errorf("Expected token to be updated to 'new-token', got '%s'", client.Token)
+		if client.Token() != "new-token" {
+			t.Errorf("Expected token to be updated to 'new-token', got '%s'", client.Token())
 		}
 	})
 }
+
+func TestNewClientHTTPSettings(t *testing.T) {
+	t.Run("Default timeout", func(t *testing.T) {
+		client := NewClient(Config{})
+		if client.HTTPClient.Timeout != DefaultTimeout {
+			t.Errorf("Expected timeout %s, got %s", DefaultTimeout, client.HTTPClient.Timeout)
+		}
+	})
+
+	t.Run("Custom timeout", func(t *testing.T) {
+		client := NewClient(Config{Timeout: 5 * time.Second})
+		if client.HTTPClient.Timeout != 5*time.Second {
+			t.Errorf("Expected timeout 5s, got %s", client.HTTPClient.Timeout)
+		}
+	})
+
+	t.Run("Insecure skip verify", func(t *testing.T) {
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"code": 0}`))
+		}))
+		defer server.Close()
+
+		req, _ := http.NewRequest("GET", server.URL+"/test", nil)
+		verifying := NewClient(Config{BaseURL: server.URL, Token: "token", Retry: &RetryPolicy{}})
+		if _, err := verifying.Do(context.Background(), req); err == nil {
+			t.Error("Expected a certificate error without insecure_skip_verify")
+		}
+
+		req, _ = http.NewRequest("GET", server.URL+"/test", nil)
+		insecure := NewClient(Config{BaseURL: server.URL, Token: "token", Retry: &RetryPolicy{}, InsecureSkipVerify: true})
+		if _, err := insecure.Do(context.Background(), req); err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+	})
+
+	t.Run("IP address is sent on login", func(t *testing.T) {
+		var loginIP string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/v1beta/auth/login" {
+				var body struct {
+					IP string `json:"ip"`
+				}
+				_ = json.NewDecoder(r.Body).Decode(&body)
+				loginIP = body.IP
+				_, _ = w.Write([]byte(`{"code": 0, "data": {"token": "new-token"}}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"code": 0}`))
+		}))
+		defer server.Close()
+
+		client := NewClient(Config{
+			BaseURL:    server.URL,
+			Username:   "testuser",
+			Password:   "testpass",
+			IPAddress:  "192.0.2.10",
+			HTTPClient: server.Client(),
+		})
+		req, _ := http.NewRequest("GET", server.URL+"/test", nil)
+		if _, err := client.Do(context.Background(), req); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if loginIP != "192.0.2.10" {
+			t.Errorf("Expected login from 192.0.2.10, got %q", loginIP)
+		}
+	})
+}
+
+func TestDoHonoursContextCancellation(t *testing.T) {
+	var sawCancelled bool
+	hc := &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if err := req.Context().Err(); err != nil {
+			sawCancelled = true
+			return nil, err
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{}`)),
+			Header:     make(http.Header),
+		}, nil
+	})}
+	client := NewClient(Config{
+		Token:      "valid-token",
+		HTTPClient: hc,
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	req, _ := http.NewRequest("GET", "http://example.com/test", nil)
+	_, err := client.Do(ctx, req)
+
+	if err == nil {
+		t.Fatal("Expected error for cancelled context, got nil")
+	}
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+	if !sawCancelled {
+		t.Error("Expected the cancelled context to reach the transport")
+	}
+}
+
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
@@ -3,6 +3,7 @@ package customers
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -32,20 +33,20 @@ type CreateCustomerResponse struct {
 // Create creates a new customer via the Openprovider API.
 //
 // Endpoint: POST https://api.openprovider.eu/v1beta/customers
-func Create(c *client.Client, req *CreateCustomerRequest) (string, error) {
+func Create(ctx context.Context, c *client.Client, req *CreateCustomerRequest) (string, error) {
 	body, err := json.Marshal(req)
 	if err != nil {
 		return "", err
 	}
 
 	path := "/v1beta/customers"
-	httpReq, err := http.NewRequest("POST", fmt.Sprintf("%s%s", c.BaseURL, path), bytes.NewBuffer(body))
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s%s", c.BaseURL, path), bytes.NewBuffer(body))
 	if err != nil {
 		return "", err
 	}
 
 	httpReq.Header.Set("Content-Type", "application/json")
-	resp, err := c.Do(httpReq)
+	resp, err := c.Do(ctx, httpReq)
 	if err != nil {
 		return "", err
 	}
@@ -2,6 +2,7 @@
 package customers_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/charpand/terraform-provider-openprovider/internal/client/customers"
@@ -31,7 +32,7 @@ func TestCreateCustomer(t *testing.T) {
 		},
 	}
 
-	handle, err := customers.Create(apiClient, req)
+	handle, err := customers.Create(context.Background(), apiClient, req)
 
 	if err != nil {
 		t.Fatalf("Expected no error, got %v", err)
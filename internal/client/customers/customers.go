@@ -2,8 +2,10 @@
 package customers
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 
 	"github.com/charpand/terraform-provider-openprovider/internal/client"
@@ -57,17 +59,31 @@ type ListCustomersResponse struct {
 	} `json:"data"`
 }
 
-// List retrieves a list of customers from the Openprovider API.
-func List(c *client.Client) ([]Customer, error) {
+// List retrieves all customers from the Openprovider API, following pagination.
+func List(ctx context.Context, c *client.Client) ([]Customer, error) {
+	return client.Collect(ListIter(ctx, c))
+}
+
+// ListIter returns an iterator over all customers, fetching pages of c.PageSize lazily.
+// Breaking out of the loop stops fetching further pages.
+func ListIter(ctx context.Context, c *client.Client) iter.Seq2[Customer, error] {
+	return client.Paginate(ctx, c.PageSize, func(ctx context.Context, limit, offset int) ([]Customer, int, error) {
+		return listPage(ctx, c, limit, offset)
+	})
+}
+
+// listPage retrieves a single page of customers.
+func listPage(ctx context.Context, c *client.Client, limit, offset int) ([]Customer, int, error) {
 	path := "/v1beta/customers"
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s%s", c.BaseURL, path), nil)
+	query := client.PageQuery(limit, offset)
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s%s?%s", c.BaseURL, path, query.Encode()), nil)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
-	resp, err := c.Do(req)
+	resp, err := c.Do(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	defer func() {
@@ -76,7 +92,7 @@ func List(c *client.Client) ([]Customer, error) {
 
 	var results ListCustomersResponse
 	if err := json.NewDecoder(resp.Body).Decode(&results); err != nil {
-		return nil, err
+		return nil, 0, err
 	}
-	return results.Data.Results, nil
+	return results.Data.Results, results.Data.Total, nil
 }
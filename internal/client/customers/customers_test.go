@@ -2,6 +2,7 @@
 package customers_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/charpand/terraform-provider-openprovider/internal/client/customers"
@@ -11,7 +12,7 @@ import (
 func TestListCustomers(t *testing.T) {
 	apiClient := testutils.SetupTestClient()
 
-	customerList, err := customers.List(apiClient)
+	customerList, err := customers.List(context.Background(), apiClient)
 
 	if err != nil {
 		t.Fatalf("Expected no error, got %v", err)
@@ -2,6 +2,7 @@
 package customers
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
@@ -11,14 +12,14 @@ import (
 // Delete deletes a customer via the Openprovider API.
 //
 // Endpoint: DELETE https://api.openprovider.eu/v1beta/customers/{handle}
-func Delete(c *client.Client, handle string) error {
+func Delete(ctx context.Context, c *client.Client, handle string) error {
 	path := fmt.Sprintf("/v1beta/customers/%s", handle)
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s%s", c.BaseURL, path), nil)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s%s", c.BaseURL, path), nil)
 	if err != nil {
 		return err
 	}
 
-	resp, err := c.Do(req)
+	resp, err := c.Do(ctx, req)
 	if err != nil {
 		return err
 	}
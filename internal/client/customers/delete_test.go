@@ -2,6 +2,7 @@
 package customers_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/charpand/terraform-provider-openprovider/internal/client/customers"
@@ -11,7 +12,7 @@ import (
 func TestDeleteCustomer(t *testing.T) {
 	apiClient := testutils.SetupTestClient()
 
-	err := customers.Delete(apiClient, "XX123456-XX")
+	err := customers.Delete(context.Background(), apiClient, "XX123456-XX")
 
 	if err != nil {
 		t.Fatalf("Expected no error, got %v", err)
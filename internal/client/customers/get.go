@@ -2,6 +2,7 @@
 package customers
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -29,17 +30,17 @@ type GetCustomerResponse struct {
 //
 // Endpoint: GET https://api.openprovider.eu/v1beta/customers/{handle}
 // Returns (nil, nil) if the customer is not found (404).
-func Get(c *client.Client, handle string) (*Customer, error) {
+func Get(ctx context.Context, c *client.Client, handle string) (*Customer, error) {
 	path := fmt.Sprintf("/v1beta/customers/%s", handle)
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s%s", c.BaseURL, path), nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s%s", c.BaseURL, path), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := c.Do(req)
+	resp, err := c.Do(ctx, req)
 	if err != nil {
 		// Check if it's a 404 error
-		if resp != nil && resp.StatusCode == http.StatusNotFound {
+		if client.IsNotFound(err) {
 			return nil, nil
 		}
 		return nil, err
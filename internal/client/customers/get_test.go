@@ -2,6 +2,7 @@
 package customers_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/charpand/terraform-provider-openprovider/internal/client/customers"
@@ -11,7 +12,7 @@ import (
 func TestGetCustomer(t *testing.T) {
 	apiClient := testutils.SetupTestClient()
 
-	customer, err := customers.Get(apiClient, "XX123456-XX")
+	customer, err := customers.Get(context.Background(), apiClient, "XX123456-XX")
 
 	if err != nil {
 		t.Fatalf("Expected no error, got %v", err)
@@ -3,6 +3,7 @@ package customers
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -32,20 +33,20 @@ type UpdateCustomerResponse struct {
 // Update updates an existing customer via the Openprovider API.
 //
 // Endpoint: PUT https://api.openprovider.eu/v1beta/customers/{handle}
-func Update(c *client.Client, handle string, req *UpdateCustomerRequest) error {
+func Update(ctx context.Context, c *client.Client, handle string, req *UpdateCustomerRequest) error {
 	body, err := json.Marshal(req)
 	if err != nil {
 		return err
 	}
 
 	path := fmt.Sprintf("/v1beta/customers/%s", handle)
-	httpReq, err := http.NewRequest("PUT", fmt.Sprintf("%s%s", c.BaseURL, path), bytes.NewBuffer(body))
+	httpReq, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s%s", c.BaseURL, path), bytes.NewBuffer(body))
 	if err != nil {
 		return err
 	}
 
 	httpReq.Header.Set("Content-Type", "application/json")
-	resp, err := c.Do(httpReq)
+	resp, err := c.Do(ctx, httpReq)
 	if err != nil {
 		return err
 	}
@@ -2,6 +2,7 @@
 package customers_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/charpand/terraform-provider-openprovider/internal/client/customers"
@@ -15,7 +16,7 @@ func TestUpdateCustomer(t *testing.T) {
 		Email: "updated@example.com",
 	}
 
-	err := customers.Update(apiClient, "XX123456-XX", req)
+	err := customers.Update(context.Background(), apiClient, "XX123456-XX", req)
 
 	if err != nil {
 		t.Fatalf("Expected no error, got %v", err)
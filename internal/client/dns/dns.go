@@ -19,13 +19,65 @@ type Record struct {
 
 // Zone represents a DNS zone.
 type Zone struct {
+	ID               int    `json:"id,omitempty"`
 	Name             string `json:"name"`
 	Extension        string `json:"extension"`
 	Type             string `json:"type,omitempty"`
+	IP               string `json:"ip,omitempty"`
+	Provider         string `json:"provider,omitempty"`
+	Secured          bool   `json:"secured,omitempty"`
+	Active           bool   `json:"active,omitempty"`
 	CreationDate     string `json:"creation_date,omitempty"`
 	ModificationDate string `json:"modification_date,omitempty"`
 }
 
+const (
+	// ZoneTypeMaster -- zone served authoritatively by OpenProvider
+	ZoneTypeMaster = "master"
+	// ZoneTypeSlave -- zone transferred from an external master
+	ZoneTypeSlave = "slave"
+
+	// ZoneProviderStandard -- standard OpenProvider DNS
+	ZoneProviderStandard = "openprovider"
+	// ZoneProviderPremium -- premium DNS (Sectigo)
+	ZoneProviderPremium = "sectigo"
+)
+
+// ZoneDomain identifies the domain a zone is created for.
+type ZoneDomain struct {
+	Name      string `json:"name"`
+	Extension string `json:"extension"`
+}
+
+// CreateZoneRequest represents a request to create a DNS zone.
+type CreateZoneRequest struct {
+	Domain       ZoneDomain `json:"domain"`
+	Type         string     `json:"type"`
+	MasterIP     string     `json:"master_ip,omitempty"`
+	TemplateName string     `json:"template_name,omitempty"`
+	Provider     string     `json:"provider,omitempty"`
+	Secured      bool       `json:"secured,omitempty"`
+	Records      []Record   `json:"records,omitempty"`
+}
+
+// UpdateZoneRequest represents a request to update a DNS zone.
+// Nil fields are left unchanged.
+type UpdateZoneRequest struct {
+	MasterIP *string        `json:"master_ip,omitempty"`
+	Provider *string        `json:"provider,omitempty"`
+	Secured  *bool          `json:"secured,omitempty"`
+	Records  *RecordUpdates `json:"records,omitempty"`
+}
+
+// ZoneResponse represents the API response for creating, updating or deleting a DNS zone.
+type ZoneResponse struct {
+	Code int `json:"code"`
+	Data struct {
+		Success bool `json:"success"`
+	} `json:"data"`
+	Desc string `json:"desc"`
+}
+
 // ListRecordsResponse represents the API response for listing DNS records.
 type ListRecordsResponse struct {
 	Code int                     `json:"code"`
@@ -101,10 +153,28 @@ type GetZoneResponse struct {
 }
 
 // RecordUpdates represents record updates for a zone.
+//
+// All changes in a RecordUpdates are applied by the API in a single request.
 type RecordUpdates struct {
-	Add     []Record `json:"add,omitempty"`
-	Remove  []Record `json:"remove,omitempty"`
+	// Add lists records to create.
+	Add []Record `json:"add,omitempty"`
+	// Remove lists records to delete, matched on name, type and value.
+	Remove []Record `json:"remove,omitempty"`
+	// Replace replaces every record in the zone with the given records.
 	Replace []Record `json:"replace,omitempty"`
+	// Update changes existing records in place, each identified by its original values.
+	Update []RecordUpdate `json:"update,omitempty"`
+}
+
+// RecordUpdate changes a single record from its original values to new ones.
+type RecordUpdate struct {
+	OriginalRecord Record `json:"original_record"`
+	Record         Record `json:"record"`
+}
+
+// IsEmpty reports whether the change set contains no changes.
+func (ru RecordUpdates) IsEmpty() bool {
+	return len(ru.Add) == 0 && len(ru.Remove) == 0 && len(ru.Replace) == 0 && len(ru.Update) == 0
 }
 
 // MarshalJSON customizes JSON marshaling for RecordUpdates.
@@ -3,78 +3,120 @@ package dns
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"iter"
 	"net/http"
 
 	"github.com/charpand/terraform-provider-openprovider/internal/client"
 )
 
-// ListRecords lists all DNS records for a zone.
+// ListRecords lists all DNS records for a zone, following pagination.
 //
 // Endpoint: GET https://api.openprovider.eu/v1beta/dns/zones/{name}/records
-func ListRecords(c *client.Client, zoneName string) ([]Record, error) {
+func ListRecords(ctx context.Context, c *client.Client, zoneName string) ([]Record, error) {
+	return client.Collect(ListRecordsIter(ctx, c, zoneName))
+}
+
+// ListRecordsIter returns an iterator over all DNS records of the zone, fetching pages of c.PageSize lazily.
+// Breaking out of the loop stops fetching further pages.
+func ListRecordsIter(ctx context.Context, c *client.Client, zoneName string) iter.Seq2[Record, error] {
+	return client.Paginate(ctx, c.PageSize, func(ctx context.Context, limit, offset int) ([]Record, int, error) {
+		return listRecordsPage(ctx, c, zoneName, limit, offset)
+	})
+}
+
+// listRecordsPage retrieves a single page of DNS records of the zone.
+func listRecordsPage(ctx context.Context, c *client.Client, zoneName string, limit, offset int) ([]Record, int, error) {
 	path := fmt.Sprintf("/v1beta/dns/zones/%s/records", zoneName)
-	httpReq, err := http.NewRequest("GET", fmt.Sprintf("%s%s", c.BaseURL, path), nil)
+	query := client.PageQuery(limit, offset)
+	httpReq, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s%s?%s", c.BaseURL, path, query.Encode()), nil)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
-	resp, err := c.Do(httpReq)
+	resp, err := c.Do(ctx, httpReq)
 	if resp != nil {
 		defer func() {
 			_ = resp.Body.Close()
 		}()
 	}
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	var result ListRecordsResponse
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
-	return result.Data.Results, nil
+	return result.Data.Results, result.Data.Total, nil
 }
 
+// ErrRecordNotFound is returned when no record in a zone matches a lookup.
+var ErrRecordNotFound = errors.New("record not found")
+
 // GetRecord retrieves a specific DNS record from a zone.
 //
 // Endpoint: GET https://api.openprovider.eu/v1beta/dns/zones/{name}/records
 // Note: The API doesn't support getting a single record directly,
-// so we retrieve all records and filter by name and type.
-func GetRecord(c *client.Client, zoneName string, recordName string, recordType string) (*Record, error) {
-	records, err := ListRecords(c, zoneName)
+// so we retrieve all records and match on name, type, value and priority.
+// A record set may hold several records with the same name and type, so
+// matching on name and type alone is not enough to identify a record.
+func GetRecord(ctx context.Context, c *client.Client, zoneName string, key RecordKey) (*Record, error) {
+	records, err := ListRecords(ctx, c, zoneName)
 	if err != nil {
 		return nil, err
 	}
 
 	for _, record := range records {
-		if record.Name == recordName && record.Type == recordType {
+		if record.Key() == key {
 			return &record, nil
 		}
 	}
 
-	return nil, fmt.Errorf("record not found: %s (type: %s)", recordName, recordType)
+	return nil, fmt.Errorf("%w: %s (type: %s, value: %s)", ErrRecordNotFound, key.Name, key.Type, key.Value)
+}
+
+// FindRecords retrieves every record with the given name and type from a zone,
+// i.e. all values of a record set.
+//
+// Endpoint: GET https://api.openprovider.eu/v1beta/dns/zones/{name}/records
+func FindRecords(ctx context.Context, c *client.Client, zoneName string, recordName string, recordType string) ([]Record, error) {
+	records, err := ListRecords(ctx, c, zoneName)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []Record
+	for _, record := range records {
+		if record.Name == recordName && record.Type == recordType {
+			matches = append(matches, record)
+		}
+	}
+
+	return matches, nil
 }
 
 // CreateRecord creates a new DNS record in a zone.
 //
 // Endpoint: POST https://api.openprovider.eu/v1beta/dns/zones/{name}/records
-func CreateRecord(c *client.Client, zoneName string, req *CreateRecordRequest) (*Record, error) {
+func CreateRecord(ctx context.Context, c *client.Client, zoneName string, req *CreateRecordRequest) (*Record, error) {
 	body, err := json.Marshal(req)
 	if err != nil {
 		return nil, err
 	}
 
 	path := fmt.Sprintf("/v1beta/dns/zones/%s/records", zoneName)
-	httpReq, err := http.NewRequest("POST", fmt.Sprintf("%s%s", c.BaseURL, path), bytes.NewBuffer(body))
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s%s", c.BaseURL, path), bytes.NewBuffer(body))
 	if err != nil {
 		return nil, err
 	}
 
 	httpReq.Header.Set("Content-Type", "application/json")
-	resp, err := c.Do(httpReq)
+	resp, err := c.Do(ctx, httpReq)
 	if resp != nil {
 		defer func() {
 			_ = resp.Body.Close()
@@ -92,46 +134,35 @@ func CreateRecord(c *client.Client, zoneName string, req *CreateRecordRequest) (
 	return &result.Data, nil
 }
 
-// UpdateRecord updates an existing DNS record in a zone.
+// UpdateRecord replaces an existing DNS record in a zone. The record to change is
+// identified by all of its original values, so a single value of a multi-value
+// record set can be updated without touching the others.
 //
-// Endpoint: PUT https://api.openprovider.eu/v1beta/dns/zones/{name}/records
-// Note: The API uses PUT to update records by filtering on name and type.
-func UpdateRecord(c *client.Client, zoneName string, _ string, _ string, req *UpdateRecordRequest) (*Record, error) {
-	body, err := json.Marshal(req)
-	if err != nil {
-		return nil, err
+// Endpoint: PUT https://api.openprovider.eu/v1beta/dns/zones/{name}
+func UpdateRecord(ctx context.Context, c *client.Client, zoneName string, original Record, req *UpdateRecordRequest) (*Record, error) {
+	record := Record{
+		Name:     req.Name,
+		Type:     req.Type,
+		Value:    req.Value,
+		TTL:      req.TTL,
+		Priority: req.Priority,
 	}
 
-	path := fmt.Sprintf("/v1beta/dns/zones/%s/records", zoneName)
-	httpReq, err := http.NewRequest("PUT", fmt.Sprintf("%s%s", c.BaseURL, path), bytes.NewBuffer(body))
-	if err != nil {
-		return nil, err
-	}
-
-	httpReq.Header.Set("Content-Type", "application/json")
-	resp, err := c.Do(httpReq)
-	if resp != nil {
-		defer func() {
-			_ = resp.Body.Close()
-		}()
+	updates := RecordUpdates{
+		Update: []RecordUpdate{{OriginalRecord: original, Record: record}},
 	}
-	if err != nil {
-		return nil, err
-	}
-
-	var result UpdateRecordResponse
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+	if err := UpdateRecords(ctx, c, zoneName, updates); err != nil {
 		return nil, err
 	}
 
-	return &result.Data, nil
+	return &record, nil
 }
 
 // DeleteRecord deletes a DNS record from a zone.
 //
 // Endpoint: DELETE https://api.openprovider.eu/v1beta/dns/zones/{name}/records
 // Note: The API uses DELETE with body to specify the record to remove.
-func DeleteRecord(c *client.Client, zoneName string, recordName string, recordType string, value string) error {
+func DeleteRecord(ctx context.Context, c *client.Client, zoneName string, recordName string, recordType string, value string) error {
 	req := DeleteRecordRequest{
 		Name:  recordName,
 		Type:  recordType,
@@ -144,13 +175,13 @@ func DeleteRecord(c *client.Client, zoneName string, recordName string, recordTy
 	}
 
 	path := fmt.Sprintf("/v1beta/dns/zones/%s/records", zoneName)
-	httpReq, err := http.NewRequest("DELETE", fmt.Sprintf("%s%s", c.BaseURL, path), bytes.NewBuffer(body))
+	httpReq, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s%s", c.BaseURL, path), bytes.NewBuffer(body))
 	if err != nil {
 		return err
 	}
 
 	httpReq.Header.Set("Content-Type", "application/json")
-	resp, err := c.Do(httpReq)
+	resp, err := c.Do(ctx, httpReq)
 	if resp != nil {
 		defer func() {
 			_ = resp.Body.Close()
@@ -174,3 +205,97 @@ type DeleteRecordRequest struct {
 	Type  string `json:"type"`
 	Value string `json:"value"`
 }
+
+// UpdateRecords applies a change set of record additions, removals and updates to a
+// zone in a single request.
+//
+// Endpoint: PUT https://api.openprovider.eu/v1beta/dns/zones/{name}
+func UpdateRecords(ctx context.Context, c *client.Client, zoneName string, updates RecordUpdates) error {
+	if updates.IsEmpty() {
+		return nil
+	}
+	return UpdateZone(ctx, c, zoneName, &UpdateZoneRequest{Records: &updates})
+}
+
+// RecordKey identifies a record within a zone. A record set may hold several records
+// with the same name and type, so the value and priority are part of the identity.
+type RecordKey struct {
+	Name     string
+	Type     string
+	Value    string
+	Priority int
+}
+
+// Key returns the identity of r.
+func (r Record) Key() RecordKey {
+	return RecordKey{Name: r.Name, Type: r.Type, Value: r.Value, Priority: r.Priority}
+}
+
+// recordSet identifies the records of a zone that share a name and type.
+type recordSet struct {
+	Name string
+	Type string
+}
+
+// DiffRecords computes the minimal change set that turns current into desired.
+// Records are matched on name, type, value and priority; matched records whose
+// TTL differs are updated in place. The remaining records of desired are paired
+// with the remaining records of current with the same name and type, in order,
+// and replace them through an update, so changing a value or priority is a single
+// change. Unpaired records are added or removed. Records repeated in desired are
+// collapsed into the first, as the API rejects adding a record twice.
+func DiffRecords(current, desired []Record) RecordUpdates {
+	existing := make(map[RecordKey]Record, len(current))
+	for _, record := range current {
+		existing[record.Key()] = record
+	}
+
+	var updates RecordUpdates
+	var unmatched []Record
+	wanted := make(map[RecordKey]bool, len(desired))
+	for _, record := range desired {
+		key := record.Key()
+		if wanted[key] {
+			continue
+		}
+		wanted[key] = true
+
+		original, ok := existing[key]
+		switch {
+		case !ok:
+			unmatched = append(unmatched, record)
+		case original.TTL != record.TTL:
+			updates.Update = append(updates.Update, RecordUpdate{OriginalRecord: original, Record: record})
+		}
+	}
+
+	// Records of current that are no longer wanted, per record set
+	stale := make(map[recordSet][]Record)
+	for _, record := range current {
+		if !wanted[record.Key()] {
+			set := recordSet{Name: record.Name, Type: record.Type}
+			stale[set] = append(stale[set], record)
+		}
+	}
+
+	for _, record := range unmatched {
+		set := recordSet{Name: record.Name, Type: record.Type}
+		if originals := stale[set]; len(originals) > 0 {
+			updates.Update = append(updates.Update, RecordUpdate{OriginalRecord: originals[0], Record: record})
+			stale[set] = originals[1:]
+			continue
+		}
+		updates.Add = append(updates.Add, record)
+	}
+
+	// Removals keep the order of current
+	for _, record := range current {
+		set := recordSet{Name: record.Name, Type: record.Type}
+		if originals := stale[set]; len(originals) > 0 && originals[0] == record {
+			updates.Remove = append(updates.Remove, record)
+			stale[set] = originals[1:]
+		}
+	}
+
+	return updates
+}
@@ -2,8 +2,13 @@
 package dns
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"net/http"
+	"net/http/httptest"
 	"os"
+	"reflect"
 	"testing"
 
 	"github.com/charpand/terraform-provider-openprovider/internal/client"
@@ -28,7 +33,7 @@ func TestListRecords(t *testing.T) {
 	}
 	c := client.NewClient(config)
 
-	records, err := ListRecords(c, "example.com")
+	records, err := ListRecords(context.Background(), c, "example.com")
 	if err != nil {
 		t.Logf("Note: API returned error (expected if mock server not running): %v", err)
 		return
@@ -60,7 +65,7 @@ func TestGetRecord(t *testing.T) {
 	}
 	c := client.NewClient(config)
 
-	record, err := GetRecord(c, "example.com", "www", "A")
+	record, err := GetRecord(context.Background(), c, "example.com", RecordKey{Name: "www", Type: "A", Value: "192.0.2.1"})
 	if err != nil {
 		t.Logf("Note: API returned error (expected if mock server not running): %v", err)
 		return
@@ -99,7 +104,7 @@ func TestCreateRecord(t *testing.T) {
 		TTL:   3600,
 	}
 
-	record, err := CreateRecord(c, "example.com", req)
+	record, err := CreateRecord(context.Background(), c, "example.com", req)
 	if err != nil {
 		t.Logf("Note: API returned error (expected if mock server not running): %v", err)
 		return
@@ -138,7 +143,7 @@ func TestUpdateRecord(t *testing.T) {
 		TTL:   7200,
 	}
 
-	record, err := UpdateRecord(c, "example.com", "test", "A", req)
+	record, err := UpdateRecord(context.Background(), c, "example.com", Record{Name: "test", Type: "A", Value: "192.0.2.1", TTL: 3600}, req)
 	if err != nil {
 		t.Logf("Note: API returned error (expected if mock server not running): %v", err)
 		return
@@ -170,7 +175,7 @@ func TestDeleteRecord(t *testing.T) {
 	}
 	c := client.NewClient(config)
 
-	err := DeleteRecord(c, "example.com", "test", "A", "192.0.2.1")
+	err := DeleteRecord(context.Background(), c, "example.com", "test", "A", "192.0.2.1")
 	if err != nil {
 		t.Logf("Note: API returned error (expected if mock server not running): %v", err)
 		return
@@ -178,3 +183,222 @@ func TestDeleteRecord(t *testing.T) {
 
 	t.Log("Successfully deleted DNS record")
 }
+
+func TestDiffRecords(t *testing.T) {
+	current := []Record{
+		{Name: "www", Type: "A", Value: "192.0.2.1", TTL: 3600},
+		{Name: "www", Type: "A", Value: "192.0.2.2", TTL: 3600},
+		{Name: "@", Type: "MX", Value: "mx1.example.com", Priority: 10, TTL: 3600},
+		{Name: "@", Type: "TXT", Value: "v=spf1 -all", TTL: 3600},
+	}
+	desired := []Record{
+		{Name: "www", Type: "A", Value: "192.0.2.1", TTL: 3600},
+		{Name: "www", Type: "A", Value: "192.0.2.3", TTL: 3600},
+		{Name: "@", Type: "MX", Value: "mx1.example.com", Priority: 20, TTL: 3600},
+		{Name: "@", Type: "TXT", Value: "v=spf1 -all", TTL: 300},
+	}
+
+	updates := DiffRecords(current, desired)
+
+	expected := RecordUpdates{
+		Update: []RecordUpdate{
+			{
+				OriginalRecord: Record{Name: "@", Type: "TXT", Value: "v=spf1 -all", TTL: 3600},
+				Record:         Record{Name: "@", Type: "TXT", Value: "v=spf1 -all", TTL: 300},
+			},
+			{
+				OriginalRecord: Record{Name: "www", Type: "A", Value: "192.0.2.2", TTL: 3600},
+				Record:         Record{Name: "www", Type: "A", Value: "192.0.2.3", TTL: 3600},
+			},
+			{
+				OriginalRecord: Record{Name: "@", Type: "MX", Value: "mx1.example.com", Priority: 10, TTL: 3600},
+				Record:         Record{Name: "@", Type: "MX", Value: "mx1.example.com", Priority: 20, TTL: 3600},
+			},
+		},
+	}
+	if !reflect.DeepEqual(updates, expected) {
+		t.Errorf("Unexpected diff:\n got: %+v\nwant: %+v", updates, expected)
+	}
+}
+
+func TestDiffRecordsAddsAndRemovesUnpairedRecords(t *testing.T) {
+	current := []Record{
+		{Name: "www", Type: "A", Value: "192.0.2.1", TTL: 3600},
+		{Name: "www", Type: "A", Value: "192.0.2.2", TTL: 3600},
+		{Name: "old", Type: "CNAME", Value: "www.example.com", TTL: 3600},
+	}
+	desired := []Record{
+		{Name: "www", Type: "A", Value: "192.0.2.3", TTL: 3600},
+		{Name: "www", Type: "AAAA", Value: "2001:db8::1", TTL: 3600},
+	}
+
+	updates := DiffRecords(current, desired)
+
+	expected := RecordUpdates{
+		Add: []Record{
+			{Name: "www", Type: "AAAA", Value: "2001:db8::1", TTL: 3600},
+		},
+		Remove: []Record{
+			{Name: "www", Type: "A", Value: "192.0.2.2", TTL: 3600},
+			{Name: "old", Type: "CNAME", Value: "www.example.com", TTL: 3600},
+		},
+		Update: []RecordUpdate{
+			{
+				OriginalRecord: Record{Name: "www", Type: "A", Value: "192.0.2.1", TTL: 3600},
+				Record:         Record{Name: "www", Type: "A", Value: "192.0.2.3", TTL: 3600},
+			},
+		},
+	}
+	if !reflect.DeepEqual(updates, expected) {
+		t.Errorf("Unexpected diff:\n got: %+v\nwant: %+v", updates, expected)
+	}
+}
+
+func TestDiffRecordsCollapsesDuplicates(t *testing.T) {
+	record := Record{Name: "www", Type: "A", Value: "192.0.2.1", TTL: 3600}
+	shortTTL := record
+	shortTTL.TTL = 60
+
+	updates := DiffRecords(nil, []Record{record, shortTTL, record})
+
+	if len(updates.Add) != 1 || updates.Add[0] != record {
+		t.Errorf("Expected the record to be added once, got %+v", updates)
+	}
+}
+
+func TestDiffRecordsNoChanges(t *testing.T) {
+	records := []Record{{Name: "www", Type: "A", Value: "192.0.2.1", TTL: 3600}}
+	if updates := DiffRecords(records, records); !updates.IsEmpty() {
+		t.Errorf("Expected no changes, got %+v", updates)
+	}
+}
+
+func TestUpdateRecordsSendsSingleRequest(t *testing.T) {
+	var requests int
+	var body struct {
+		Records RecordUpdates `json:"records"`
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Method != "PUT" || r.URL.Path != "/v1beta/dns/zones/example.com" {
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		_, _ = w.Write([]byte(`{"code": 0, "data": {"success": true}}`))
+	}))
+	defer server.Close()
+
+	c := client.NewClient(client.Config{
+		BaseURL:    server.URL,
+		Token:      "test-token",
+		HTTPClient: server.Client(),
+	})
+
+	updates := RecordUpdates{
+		Add:    []Record{{Name: "www", Type: "A", Value: "192.0.2.1", TTL: 3600}},
+		Remove: []Record{{Name: "old", Type: "A", Value: "192.0.2.9", TTL: 3600}},
+	}
+	if err := UpdateRecords(context.Background(), c, "example.com", updates); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if requests != 1 {
+		t.Errorf("Expected a single request, got %d", requests)
+	}
+	if !reflect.DeepEqual(body.Records, updates) {
+		t.Errorf("Unexpected request body: %+v", body.Records)
+	}
+
+	// An empty change set does not reach the API.
+	if err := UpdateRecords(context.Background(), c, "example.com", RecordUpdates{}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if requests != 1 {
+		t.Errorf("Expected no request for an empty change set, got %d", requests)
+	}
+}
+
+func TestGetRecordMatchesFullTuple(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"code": 0, "data": {"results": [
+			{"name": "@", "type": "MX", "value": "mx.example.com", "ttl": 3600, "prio": 10},
+			{"name": "@", "type": "MX", "value": "mx.example.com", "ttl": 600, "prio": 20},
+			{"name": "www", "type": "A", "value": "192.0.2.1", "ttl": 3600},
+			{"name": "www", "type": "A", "value": "192.0.2.2", "ttl": 300}
+		], "total": 4}}`))
+	}))
+	defer server.Close()
+
+	c := client.NewClient(client.Config{
+		BaseURL:    server.URL,
+		Token:      "test-token",
+		HTTPClient: server.Client(),
+	})
+
+	record, err := GetRecord(context.Background(), c, "example.com", RecordKey{Name: "www", Type: "A", Value: "192.0.2.2"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if record.TTL != 300 {
+		t.Errorf("Expected the second value of the record set, got %+v", record)
+	}
+
+	record, err = GetRecord(context.Background(), c, "example.com", RecordKey{Name: "@", Type: "MX", Value: "mx.example.com", Priority: 20})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if record.TTL != 600 {
+		t.Errorf("Expected the record with priority 20, got %+v", record)
+	}
+
+	_, err = GetRecord(context.Background(), c, "example.com", RecordKey{Name: "www", Type: "A", Value: "192.0.2.3"})
+	if !errors.Is(err, ErrRecordNotFound) {
+		t.Errorf("Expected ErrRecordNotFound, got %v", err)
+	}
+
+	records, err := FindRecords(context.Background(), c, "example.com", "www", "A")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(records) != 2 {
+		t.Errorf("Expected 2 records in the set, got %+v", records)
+	}
+}
+
+func TestUpdateRecordSendsOriginalRecord(t *testing.T) {
+	var body struct {
+		Records RecordUpdates `json:"records"`
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" || r.URL.Path != "/v1beta/dns/zones/example.com" {
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		_, _ = w.Write([]byte(`{"code": 0, "data": {"success": true}}`))
+	}))
+	defer server.Close()
+
+	c := client.NewClient(client.Config{
+		BaseURL:    server.URL,
+		Token:      "test-token",
+		HTTPClient: server.Client(),
+	})
+
+	original := Record{Name: "www", Type: "A", Value: "192.0.2.1", TTL: 3600}
+	req := &UpdateRecordRequest{Name: "www", Type: "A", Value: "192.0.2.9", TTL: 300}
+
+	record, err := UpdateRecord(context.Background(), c, "example.com", original, req)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if record.Value != "192.0.2.9" {
+		t.Errorf("Expected the new record to be returned, got %+v", record)
+	}
+
+	expected := RecordUpdates{Update: []RecordUpdate{{
+		OriginalRecord: original,
+		Record:         Record{Name: "www", Type: "A", Value: "192.0.2.9", TTL: 300},
+	}}}
+	if !reflect.DeepEqual(body.Records, expected) {
+		t.Errorf("Unexpected request body: %+v", body.Records)
+	}
+}
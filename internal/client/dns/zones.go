@@ -2,52 +2,69 @@
 package dns
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 
 	"github.com/charpand/terraform-provider-openprovider/internal/client"
 )
 
-// ListZones lists all DNS zones.
+// ListZones lists all DNS zones, following pagination.
 //
 // Endpoint: GET https://api.openprovider.eu/v1beta/dns/zones
-func ListZones(c *client.Client) ([]Zone, error) {
+func ListZones(ctx context.Context, c *client.Client) ([]Zone, error) {
+	return client.Collect(ListZonesIter(ctx, c))
+}
+
+// ListZonesIter returns an iterator over all DNS zones, fetching pages of c.PageSize lazily.
+// Breaking out of the loop stops fetching further pages.
+func ListZonesIter(ctx context.Context, c *client.Client) iter.Seq2[Zone, error] {
+	return client.Paginate(ctx, c.PageSize, func(ctx context.Context, limit, offset int) ([]Zone, int, error) {
+		return listZonesPage(ctx, c, limit, offset)
+	})
+}
+
+// listZonesPage retrieves a single page of DNS zones.
+func listZonesPage(ctx context.Context, c *client.Client, limit, offset int) ([]Zone, int, error) {
 	path := "/v1beta/dns/zones"
-	httpReq, err := http.NewRequest("GET", fmt.Sprintf("%s%s", c.BaseURL, path), nil)
+	query := client.PageQuery(limit, offset)
+	httpReq, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s%s?%s", c.BaseURL, path, query.Encode()), nil)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
-	resp, err := c.Do(httpReq)
+	resp, err := c.Do(ctx, httpReq)
 	if resp != nil {
 		defer func() {
 			_ = resp.Body.Close()
 		}()
 	}
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	var result ListZonesResponse
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
-	return result.Data.Results, nil
+	return result.Data.Results, result.Data.Total, nil
 }
 
 // GetZone retrieves a specific DNS zone by name.
 //
 // Endpoint: GET https://api.openprovider.eu/v1beta/dns/zones/{name}
-func GetZone(c *client.Client, zoneName string) (*Zone, error) {
+func GetZone(ctx context.Context, c *client.Client, zoneName string) (*Zone, error) {
 	path := fmt.Sprintf("/v1beta/dns/zones/%s", zoneName)
-	httpReq, err := http.NewRequest("GET", fmt.Sprintf("%s%s", c.BaseURL, path), nil)
+	httpReq, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s%s", c.BaseURL, path), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := c.Do(httpReq)
+	resp, err := c.Do(ctx, httpReq)
 	if resp != nil {
 		defer func() {
 			_ = resp.Body.Close()
@@ -64,3 +81,77 @@ func GetZone(c *client.Client, zoneName string) (*Zone, error) {
 
 	return &result.Data, nil
 }
+
+// CreateZone creates a new DNS zone.
+//
+// Endpoint: POST https://api.openprovider.eu/v1beta/dns/zones
+func CreateZone(ctx context.Context, c *client.Client, req *CreateZoneRequest) error {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	path := "/v1beta/dns/zones"
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s%s", c.BaseURL, path), bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+
+	return doZoneRequest(ctx, c, httpReq)
+}
+
+// UpdateZone updates an existing DNS zone.
+//
+// Endpoint: PUT https://api.openprovider.eu/v1beta/dns/zones/{name}
+func UpdateZone(ctx context.Context, c *client.Client, zoneName string, req *UpdateZoneRequest) error {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("/v1beta/dns/zones/%s", zoneName)
+	httpReq, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s%s", c.BaseURL, path), bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+
+	return doZoneRequest(ctx, c, httpReq)
+}
+
+// DeleteZone deletes a DNS zone together with all of its records.
+//
+// Endpoint: DELETE https://api.openprovider.eu/v1beta/dns/zones/{name}
+func DeleteZone(ctx context.Context, c *client.Client, zoneName string) error {
+	path := fmt.Sprintf("/v1beta/dns/zones/%s", zoneName)
+	httpReq, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s%s", c.BaseURL, path), nil)
+	if err != nil {
+		return err
+	}
+
+	return doZoneRequest(ctx, c, httpReq)
+}
+
+// doZoneRequest sends a zone mutation and checks the success flag in the response.
+func doZoneRequest(ctx context.Context, c *client.Client, httpReq *http.Request) error {
+	httpReq.Header.Set("Content-Type", "application/json")
+	resp, err := c.Do(ctx, httpReq)
+	if resp != nil {
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+	}
+	if err != nil {
+		return err
+	}
+
+	var result ZoneResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return err
+	}
+
+	if !result.Data.Success {
+		return fmt.Errorf("zone operation %s %s was not successful", httpReq.Method, httpReq.URL.Path)
+	}
+
+	return nil
+}
@@ -2,7 +2,10 @@
 package dns
 
 import (
+	"context"
+	"encoding/json"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 
@@ -28,7 +31,7 @@ func TestListZones(t *testing.T) {
 	}
 	c := client.NewClient(config)
 
-	zones, err := ListZones(c)
+	zones, err := ListZones(context.Background(), c)
 	if err != nil {
 		t.Logf("Note: API returned error (expected if mock server not running): %v", err)
 		return
@@ -60,7 +63,7 @@ func TestGetZone(t *testing.T) {
 	}
 	c := client.NewClient(config)
 
-	zone, err := GetZone(c, "example.com")
+	zone, err := GetZone(context.Background(), c, "example.com")
 	if err != nil {
 		t.Logf("Note: API returned error (expected if mock server not running): %v", err)
 		return
@@ -73,3 +76,118 @@ func TestGetZone(t *testing.T) {
 
 	t.Logf("Retrieved DNS zone: %s.%s", zone.Name, zone.Extension)
 }
+
+func TestCreateZone(t *testing.T) {
+	baseURL := os.Getenv("TEST_API_BASE_URL")
+	if baseURL == "" {
+		baseURL = "http://localhost:4010"
+	}
+
+	httpClient := &http.Client{
+		Transport: &testutils.MockTransport{RT: http.DefaultTransport},
+	}
+
+	config := client.Config{
+		BaseURL:    baseURL,
+		Username:   "test",
+		Password:   "test",
+		HTTPClient: httpClient,
+	}
+	c := client.NewClient(config)
+
+	req := &CreateZoneRequest{
+		Domain: ZoneDomain{Name: "example", Extension: "com"},
+		Type:   ZoneTypeMaster,
+	}
+
+	if err := CreateZone(context.Background(), c, req); err != nil {
+		t.Logf("Note: API returned error (expected if mock server not running): %v", err)
+		return
+	}
+
+	t.Log("Created DNS zone: example.com")
+}
+
+func TestUpdateZone(t *testing.T) {
+	baseURL := os.Getenv("TEST_API_BASE_URL")
+	if baseURL == "" {
+		baseURL = "http://localhost:4010"
+	}
+
+	httpClient := &http.Client{
+		Transport: &testutils.MockTransport{RT: http.DefaultTransport},
+	}
+
+	config := client.Config{
+		BaseURL:    baseURL,
+		Username:   "test",
+		Password:   "test",
+		HTTPClient: httpClient,
+	}
+	c := client.NewClient(config)
+
+	provider := ZoneProviderPremium
+	req := &UpdateZoneRequest{Provider: &provider}
+
+	if err := UpdateZone(context.Background(), c, "example.com", req); err != nil {
+		t.Logf("Note: API returned error (expected if mock server not running): %v", err)
+		return
+	}
+
+	t.Log("Updated DNS zone: example.com")
+}
+
+func TestDeleteZone(t *testing.T) {
+	baseURL := os.Getenv("TEST_API_BASE_URL")
+	if baseURL == "" {
+		baseURL = "http://localhost:4010"
+	}
+
+	httpClient := &http.Client{
+		Transport: &testutils.MockTransport{RT: http.DefaultTransport},
+	}
+
+	config := client.Config{
+		BaseURL:    baseURL,
+		Username:   "test",
+		Password:   "test",
+		HTTPClient: httpClient,
+	}
+	c := client.NewClient(config)
+
+	if err := DeleteZone(context.Background(), c, "example.com"); err != nil {
+		t.Logf("Note: API returned error (expected if mock server not running): %v", err)
+		return
+	}
+
+	t.Log("Deleted DNS zone: example.com")
+}
+
+func TestZoneMutationRequiresSuccess(t *testing.T) {
+	var body map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		_, _ = w.Write([]byte(`{"code": 0, "data": {"success": false}}`))
+	}))
+	defer server.Close()
+
+	c := client.NewClient(client.Config{
+		BaseURL:    server.URL,
+		Token:      "test-token",
+		HTTPClient: server.Client(),
+	})
+
+	secured := false
+	err := UpdateZone(context.Background(), c, "example.com", &UpdateZoneRequest{Secured: &secured})
+	if err == nil {
+		t.Fatal("Expected an error when the API reports no success")
+	}
+
+	// Explicitly disabled flags must still be sent, untouched fields omitted.
+	if v, ok := body["secured"]; !ok || v != false {
+		t.Errorf("Expected secured=false in request body, got %v", body)
+	}
+	if _, ok := body["master_ip"]; ok {
+		t.Errorf("Expected master_ip to be omitted, got %v", body)
+	}
+}
@@ -0,0 +1,190 @@
+// Package domains provides functionality for working with domains.
+package domains
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client"
+)
+
+// AdditionalData carries registrant data that some registries require on top of
+// the contact handles, such as the .us nexus category or the .ca legal type.
+type AdditionalData struct {
+	ApplicationPurpose   string `json:"application_purpose,omitempty"`
+	CountryOfCitizenship string `json:"country_of_citizenship,omitempty"`
+	EntityType           string `json:"entity_type,omitempty"`
+	IDNumber             string `json:"id_number,omitempty"`
+	IDType               string `json:"id_type,omitempty"`
+	LegalType            string `json:"legal_type,omitempty"`
+	NexusCategory        string `json:"nexus_category,omitempty"`
+	VAT                  string `json:"vat,omitempty"`
+}
+
+// value returns the field of d with the given JSON name.
+func (d *AdditionalData) value(name string) string {
+	if d == nil {
+		return ""
+	}
+	switch name {
+	case "application_purpose":
+		return d.ApplicationPurpose
+	case "country_of_citizenship":
+		return d.CountryOfCitizenship
+	case "entity_type":
+		return d.EntityType
+	case "id_number":
+		return d.IDNumber
+	case "id_type":
+		return d.IDType
+	case "legal_type":
+		return d.LegalType
+	case "nexus_category":
+		return d.NexusCategory
+	case "vat":
+		return d.VAT
+	default:
+		return ""
+	}
+}
+
+// AdditionalDataField describes a field of AdditionalData used by a registry.
+type AdditionalDataField struct {
+	// Name is the JSON name of the field, e.g. "nexus_category".
+	Name string
+	// Description says what the registry expects in the field.
+	Description string
+	// Required fields must be set to register a domain under the extension.
+	Required bool
+	// Values lists the accepted values; empty accepts any value.
+	Values []string
+}
+
+// additionalDataCatalogue lists the additional data fields per extension. The
+// fields of an extension also apply to the extensions below it, e.g. com.es.
+//
+// The table is maintained by hand rather than fetched from the TLD API. The API
+// only names the required fields of an extension; it reports no optional fields,
+// accepted values or descriptions. It also cannot be queried during config
+// validation, which runs before the provider is configured. Update the table when
+// a registry changes its requirements.
+var additionalDataCatalogue = map[string][]AdditionalDataField{
+	"ca": {
+		{
+			Name:        "legal_type",
+			Description: "the registrant's Canadian presence category",
+			Required:    true,
+			Values:      []string{"ABO", "ASS", "CCO", "CCT", "EDU", "GOV", "HOP", "INB", "LAM", "LGR", "MAJ", "OMK", "PLT", "PRT", "RES", "TDM", "TRD", "TRS"},
+		},
+	},
+	"es": {
+		{
+			Name:        "id_type",
+			Description: "the type of the registrant's Spanish identity document",
+			Required:    true,
+			Values:      []string{"dni", "nie", "nif", "passport", "other"},
+		},
+		{
+			Name:        "id_number",
+			Description: "the number of the registrant's identity document",
+			Required:    true,
+		},
+	},
+	"eu": {
+		{
+			Name:        "country_of_citizenship",
+			Description: "the EU country of citizenship of a registrant residing outside the EU, as an ISO 3166-1 alpha-2 code",
+		},
+	},
+	"it": {
+		{
+			Name:        "entity_type",
+			Description: "the registrant's entity type: 1 (natural person), 2 (company), 3 (freelancer), 4 (non-profit), 5 (public body), 6 (other) or 7 (foreign entity)",
+			Required:    true,
+			Values:      []string{"1", "2", "3", "4", "5", "6", "7"},
+		},
+		{
+			Name:        "id_number",
+			Description: "the registrant's tax code (codice fiscale) or VAT number",
+			Required:    true,
+		},
+	},
+	"ro": {
+		{
+			Name:        "id_number",
+			Description: "the registrant's personal numeric code (CNP) or, for organisations, the company registration number",
+			Required:    true,
+		},
+		{
+			Name:        "vat",
+			Description: "the registrant's VAT number, for VAT-registered organisations",
+		},
+	},
+	"us": {
+		{
+			Name:        "nexus_category",
+			Description: "the registrant's nexus with the United States",
+			Required:    true,
+			Values:      []string{"C11", "C12", "C21", "C31", "C32"},
+		},
+		{
+			Name:        "application_purpose",
+			Description: "the intended use of the domain",
+			Required:    true,
+			Values:      []string{"P1", "P2", "P3", "P4", "P5"},
+		},
+	},
+}
+
+// AdditionalDataFields returns the additional data fields used by the registry of
+// extension, e.g. "us" or "com.es", or nil if it needs none.
+func AdditionalDataFields(extension string) []AdditionalDataField {
+	extension = strings.ToLower(extension)
+	for {
+		if fields, ok := additionalDataCatalogue[extension]; ok {
+			return fields
+		}
+		_, parent, ok := strings.Cut(extension, ".")
+		if !ok {
+			return nil
+		}
+		extension = parent
+	}
+}
+
+// ValidateAdditionalData checks data against the requirements of the registry of
+// extension and returns a FieldError for every missing or invalid field. A nil
+// data has no fields set.
+func ValidateAdditionalData(extension string, data *AdditionalData) []client.FieldError {
+	var problems []client.FieldError
+	for _, field := range AdditionalDataFields(extension) {
+		value := data.value(field.Name)
+
+		if value == "" {
+			if field.Required {
+				problems = append(problems, client.FieldError{
+					Field:   field.Name,
+					Message: fmt.Sprintf("is required for .%s domains: %s%s", extension, field.Description, valuesHint(field.Values)),
+				})
+			}
+			continue
+		}
+
+		if len(field.Values) > 0 && !slices.Contains(field.Values, value) {
+			problems = append(problems, client.FieldError{
+				Field:   field.Name,
+				Message: fmt.Sprintf("must be one of %s for .%s domains, got %q", strings.Join(field.Values, ", "), extension, value),
+			})
+		}
+	}
+	return problems
+}
+
+// valuesHint returns a hint listing the accepted values, if any.
+func valuesHint(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return " (one of " + strings.Join(values, ", ") + ")"
+}
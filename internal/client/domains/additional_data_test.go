@@ -0,0 +1,55 @@
+// Package domains_test contains tests for the domains package.
+package domains_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client/domains"
+)
+
+func TestAdditionalDataFields(t *testing.T) {
+	if fields := domains.AdditionalDataFields("com"); fields != nil {
+		t.Errorf("Expected no fields for .com, got %v", fields)
+	}
+	if fields := domains.AdditionalDataFields("us"); len(fields) != 2 {
+		t.Errorf("Expected 2 fields for .us, got %v", fields)
+	}
+	// Extensions below a ccTLD share its requirements
+	if fields := domains.AdditionalDataFields("com.es"); len(fields) == 0 || fields[0].Name != "id_type" {
+		t.Errorf("Expected the .es fields for .com.es, got %v", fields)
+	}
+}
+
+func TestValidateAdditionalData(t *testing.T) {
+	tests := []struct {
+		name       string
+		extension  string
+		data       *domains.AdditionalData
+		wantFields []string
+	}{
+		{"No requirements", "com", nil, nil},
+		{"Missing", "us", nil, []string{"nexus_category", "application_purpose"}},
+		{"Complete", "us", &domains.AdditionalData{NexusCategory: "C11", ApplicationPurpose: "P1"}, nil},
+		{"Invalid value", "us", &domains.AdditionalData{NexusCategory: "C99", ApplicationPurpose: "P1"}, []string{"nexus_category"}},
+		{"Optional only", "eu", nil, nil},
+		{"Invalid entity type", "it", &domains.AdditionalData{EntityType: "9", IDNumber: "RSSMRA80A01H501U"}, []string{"entity_type"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			problems := domains.ValidateAdditionalData(tt.extension, tt.data)
+			if len(problems) != len(tt.wantFields) {
+				t.Fatalf("Expected problems with %v, got %v", tt.wantFields, problems)
+			}
+			for i, problem := range problems {
+				if problem.Field != tt.wantFields[i] {
+					t.Errorf("Expected a problem with %s, got %s", tt.wantFields[i], problem.Field)
+				}
+				if !strings.Contains(problem.Message, "."+tt.extension) {
+					t.Errorf("Expected the extension in %q", problem.Message)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+// Package domains provides functionality for working with domains.
+package domains
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client"
+)
+
+// AuthCode is the EPP/authorization code needed to transfer a domain away.
+type AuthCode struct {
+	AuthCode string `json:"auth_code"`
+	Type     string `json:"type,omitempty"` // e.g. "internal" or "external"
+}
+
+// AuthCodeResponse represents a response from the auth code endpoints.
+type AuthCodeResponse struct {
+	Code int      `json:"code"`
+	Data AuthCode `json:"data"`
+}
+
+// GetAuthCode retrieves the current auth code of a domain by ID.
+//
+// Endpoint: GET https://api.openprovider.eu/v1beta/domains/{id}/authcode
+func GetAuthCode(ctx context.Context, c *client.Client, id int) (*AuthCode, error) {
+	return authCodeRequest(ctx, c, "GET", fmt.Sprintf("/v1beta/domains/%d/authcode", id))
+}
+
+// ResetAuthCode generates a new auth code for a domain by ID and returns it. The
+// previous code stops working.
+//
+// Endpoint: POST https://api.openprovider.eu/v1beta/domains/{id}/authcode/reset
+func ResetAuthCode(ctx context.Context, c *client.Client, id int) (*AuthCode, error) {
+	return authCodeRequest(ctx, c, "POST", fmt.Sprintf("/v1beta/domains/%d/authcode/reset", id))
+}
+
+// authCodeRequest sends a request to an auth code endpoint and decodes the code.
+func authCodeRequest(ctx context.Context, c *client.Client, method, path string) (*AuthCode, error) {
+	req, err := http.NewRequestWithContext(ctx, method, fmt.Sprintf("%s%s", c.BaseURL, path), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	// Non-zero API error codes are surfaced by c.Do as a *client.APIError.
+	var result AuthCodeResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return &result.Data, nil
+}
@@ -0,0 +1,82 @@
+// Package domains_test contains tests for the domains package.
+package domains_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client"
+	"github.com/charpand/terraform-provider-openprovider/internal/client/domains"
+)
+
+func TestGetAuthCode(t *testing.T) {
+	var method, path string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		_, _ = w.Write([]byte(`{"code": 0, "data": {"auth_code": "Xy7!abc", "type": "internal"}}`))
+	}))
+	defer server.Close()
+
+	apiClient := client.NewClient(client.Config{
+		BaseURL:    server.URL,
+		Token:      "test-token",
+		HTTPClient: server.Client(),
+	})
+
+	code, err := domains.GetAuthCode(context.Background(), apiClient, 123)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if method != "GET" || path != "/v1beta/domains/123/authcode" {
+		t.Errorf("Unexpected request: %s %s", method, path)
+	}
+	if code.AuthCode != "Xy7!abc" || code.Type != "internal" {
+		t.Errorf("Unexpected auth code: %+v", code)
+	}
+}
+
+func TestResetAuthCode(t *testing.T) {
+	var method, path string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		_, _ = w.Write([]byte(`{"code": 0, "data": {"auth_code": "New#123", "type": "internal"}}`))
+	}))
+	defer server.Close()
+
+	apiClient := client.NewClient(client.Config{
+		BaseURL:    server.URL,
+		Token:      "test-token",
+		HTTPClient: server.Client(),
+	})
+
+	code, err := domains.ResetAuthCode(context.Background(), apiClient, 123)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if method != "POST" || path != "/v1beta/domains/123/authcode/reset" {
+		t.Errorf("Unexpected request: %s %s", method, path)
+	}
+	if code.AuthCode != "New#123" {
+		t.Errorf("Expected the new auth code, got %q", code.AuthCode)
+	}
+}
+
+func TestGetAuthCodeNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"code": 320, "desc": "Domain not found"}`))
+	}))
+	defer server.Close()
+
+	apiClient := client.NewClient(client.Config{
+		BaseURL:    server.URL,
+		Token:      "test-token",
+		HTTPClient: server.Client(),
+	})
+
+	if _, err := domains.GetAuthCode(context.Background(), apiClient, 123); !client.IsNotFound(err) {
+		t.Errorf("Expected a not found error, got %v", err)
+	}
+}
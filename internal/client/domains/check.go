@@ -0,0 +1,107 @@
+// Package domains provides functionality for working with domains.
+package domains
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client"
+	"github.com/charpand/terraform-provider-openprovider/internal/domainname"
+)
+
+// Availability statuses returned by Check.
+const (
+	// CheckStatusFree -- the domain can be registered
+	CheckStatusFree = "free"
+	// CheckStatusActive -- the domain is registered
+	CheckStatusActive = "active"
+	// CheckStatusReserved -- the domain is reserved by the registry
+	CheckStatusReserved = "reserved"
+)
+
+// CheckDomain identifies a domain in a CheckDomainRequest.
+type CheckDomain struct {
+	Name      string `json:"name"`
+	Extension string `json:"extension"`
+}
+
+// CheckDomainRequest represents a request to check the availability of domains.
+type CheckDomainRequest struct {
+	Domains   []CheckDomain `json:"domains"`
+	WithPrice bool          `json:"with_price,omitempty"`
+}
+
+// CheckResult is the availability of a single domain.
+type CheckResult struct {
+	// Domain is the domain name in ASCII form, e.g. "xn--mnchen-3ya.de".
+	Domain string `json:"domain"`
+	// Status is CheckStatusFree, CheckStatusActive or CheckStatusReserved.
+	Status string `json:"status"`
+	// Reason explains why a domain is not free, if the registry gives one.
+	Reason    string `json:"reason,omitempty"`
+	IsPremium bool   `json:"is_premium"`
+	// Price is the registration price. It is only set when prices were requested.
+	Price *struct {
+		Product  Amount `json:"product"`
+		Reseller Amount `json:"reseller"`
+	} `json:"price,omitempty"`
+}
+
+// CheckDomainResponse represents a response for checking domains.
+type CheckDomainResponse struct {
+	Code int `json:"code"`
+	Data struct {
+		Results []CheckResult `json:"results"`
+	} `json:"data"`
+}
+
+// Check looks up whether the named domains, such as "example.com" or "münchen.de",
+// can be registered. With withPrice set, each result carries its registration price.
+//
+// Endpoint: POST https://api.openprovider.eu/v1beta/domains/check
+func Check(ctx context.Context, c *client.Client, names []string, withPrice bool) ([]CheckResult, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	checkReq := CheckDomainRequest{WithPrice: withPrice}
+	for _, name := range names {
+		parsed, err := domainname.Parse(name)
+		if err != nil {
+			return nil, err
+		}
+		checkReq.Domains = append(checkReq.Domains, CheckDomain{Name: parsed.Label, Extension: parsed.Extension})
+	}
+
+	body, err := json.Marshal(checkReq)
+	if err != nil {
+		return nil, err
+	}
+
+	path := "/v1beta/domains/check"
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s%s", c.BaseURL, path), bytes.NewBuffer(body))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	// Non-zero API error codes are surfaced by c.Do as a *client.APIError.
+	var result CheckDomainResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return result.Data.Results, nil
+}
@@ -0,0 +1,65 @@
+// Package domains_test contains tests for the domains package.
+package domains_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client"
+	"github.com/charpand/terraform-provider-openprovider/internal/client/domains"
+)
+
+func TestCheckDomains(t *testing.T) {
+	var method, path string
+	var body domains.CheckDomainRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		_, _ = w.Write([]byte(`{"code": 0, "data": {"results": [
+			{"domain": "example.co.uk", "status": "free", "is_premium": false,
+			 "price": {"product": {"currency": "GBP", "price": 5.5}, "reseller": {"currency": "EUR", "price": 6.25}}},
+			{"domain": "xn--mnchen-3ya.de", "status": "active", "reason": "Domain exists"}
+		]}}`))
+	}))
+	defer server.Close()
+
+	apiClient := client.NewClient(client.Config{
+		BaseURL:    server.URL,
+		Token:      "test-token",
+		HTTPClient: server.Client(),
+	})
+
+	results, err := domains.Check(context.Background(), apiClient, []string{"example.co.uk", "münchen.de"}, true)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if method != "POST" || path != "/v1beta/domains/check" {
+		t.Errorf("Unexpected request: %s %s", method, path)
+	}
+
+	want := []domains.CheckDomain{{Name: "example", Extension: "co.uk"}, {Name: "xn--mnchen-3ya", Extension: "de"}}
+	if len(body.Domains) != 2 || body.Domains[0] != want[0] || body.Domains[1] != want[1] || !body.WithPrice {
+		t.Errorf("Unexpected request body: %+v", body)
+	}
+
+	if len(results) != 2 {
+		t.Fatalf("Expected 2 results, got %d", len(results))
+	}
+	if results[0].Status != domains.CheckStatusFree || results[0].Price == nil || results[0].Price.Reseller.Price != 6.25 {
+		t.Errorf("Unexpected first result: %+v", results[0])
+	}
+	if results[1].Status != domains.CheckStatusActive || results[1].Reason != "Domain exists" || results[1].Price != nil {
+		t.Errorf("Unexpected second result: %+v", results[1])
+	}
+}
+
+func TestCheckDomainsInvalidName(t *testing.T) {
+	apiClient := client.NewClient(client.Config{Token: "test-token"})
+
+	if _, err := domains.Check(context.Background(), apiClient, []string{"example.com", "www.example.com"}, false); err == nil {
+		t.Error("Expected an error for a subdomain")
+	}
+}
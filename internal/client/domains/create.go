@@ -3,6 +3,7 @@ package domains
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -16,16 +17,22 @@ type CreateDomainRequest struct {
 		Name      string `json:"name"`
 		Extension string `json:"extension"`
 	} `json:"domain"`
-	OwnerHandle     string       `json:"owner_handle"`
-	AdminHandle     string       `json:"admin_handle,omitempty"`
-	TechHandle      string       `json:"tech_handle,omitempty"`
-	BillingHandle   string       `json:"billing_handle,omitempty"`
-	Period          int          `json:"period,omitempty"`
-	Autorenew       string       `json:"autorenew,omitempty"`
-	Nameservers     []Nameserver `json:"name_servers,omitempty"`
-	NSGroup         string       `json:"ns_group,omitempty"`
-	DnssecKeys      []DnssecKey  `json:"dnssec_keys,omitempty"`
-	IsDnssecEnabled *bool        `json:"is_dnssec_enabled,omitempty"`
+	OwnerHandle     string          `json:"owner_handle"`
+	AdminHandle     string          `json:"admin_handle,omitempty"`
+	TechHandle      string          `json:"tech_handle,omitempty"`
+	BillingHandle   string          `json:"billing_handle,omitempty"`
+	Period          int             `json:"period,omitempty"`
+	Autorenew       string          `json:"autorenew,omitempty"`
+	Nameservers     []Nameserver    `json:"name_servers,omitempty"`
+	NSGroup         string          `json:"ns_group,omitempty"`
+	DnssecKeys      []DnssecKey     `json:"dnssec_keys,omitempty"`
+	IsDnssecEnabled *bool           `json:"is_dnssec_enabled,omitempty"`
+	IsPrivateWhois  *bool           `json:"is_private_whois_enabled,omitempty"`
+	AdditionalData  *AdditionalData `json:"additional_data,omitempty"`
+	// AcceptPremiumFee is the premium price, in the reseller account's currency,
+	// accepted for registering a premium domain (see PremiumPrice). The API
+	// refuses premium registrations without it.
+	AcceptPremiumFee float64 `json:"accept_premium_fee,omitempty"`
 }
 
 // CreateDomainResponse represents a response for creating a domain.
@@ -37,20 +44,20 @@ type CreateDomainResponse struct {
 // Create creates a new domain via the Openprovider API.
 //
 // Endpoint: POST https://api.openprovider.eu/v1beta/domains
-func Create(c *client.Client, req *CreateDomainRequest) (*Domain, error) {
+func Create(ctx context.Context, c *client.Client, req *CreateDomainRequest) (*Domain, error) {
 	body, err := json.Marshal(req)
 	if err != nil {
 		return nil, err
 	}
 
 	path := "/v1beta/domains"
-	httpReq, err := http.NewRequest("POST", fmt.Sprintf("%s%s", c.BaseURL, path), bytes.NewBuffer(body))
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s%s", c.BaseURL, path), bytes.NewBuffer(body))
 	if err != nil {
 		return nil, err
 	}
 
 	httpReq.Header.Set("Content-Type", "application/json")
-	resp, err := c.Do(httpReq)
+	resp, err := c.Do(ctx, httpReq)
 	if resp != nil {
 		defer func() {
 			_ = resp.Body.Close()
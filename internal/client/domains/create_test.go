@@ -2,6 +2,7 @@
 package domains_test
 
 import (
+	"context"
 	"net/http"
 	"os"
 	"testing"
@@ -21,7 +22,7 @@ func TestCreateDomain(t *testing.T) {
 	req.OwnerHandle = "testowner"
 	req.Period = 1
 
-	domain, err := domains.Create(apiClient, req)
+	domain, err := domains.Create(context.Background(), apiClient, req)
 
 	if err != nil {
 		t.Fatalf("Expected no error, got %v", err)
@@ -52,7 +53,7 @@ func TestCreateDomainWithNameservers(t *testing.T) {
 		{Name: "ns2.example.com"},
 	}
 
-	domain, err := domains.Create(apiClient, req)
+	domain, err := domains.Create(context.Background(), apiClient, req)
 
 	if err != nil {
 		t.Fatalf("Expected no error, got %v", err)
@@ -87,7 +88,7 @@ func TestCreateDomainWithDSRecords(t *testing.T) {
 		},
 	}
 
-	domain, err := domains.Create(apiClient, req)
+	domain, err := domains.Create(context.Background(), apiClient, req)
 
 	if err != nil {
 		t.Fatalf("Expected no error, got %v", err)
@@ -131,7 +132,7 @@ func TestCreateDomainWithError(t *testing.T) {
 	req.OwnerHandle = "testowner"
 	req.Period = 1
 
-	domain, err := domains.Create(apiClient, req)
+	domain, err := domains.Create(context.Background(), apiClient, req)
 
 	if err == nil {
 		t.Fatal("Expected error for 500 status code, got nil")
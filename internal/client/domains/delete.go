@@ -2,6 +2,7 @@
 package domains
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -20,14 +21,14 @@ type DeleteDomainResponse struct {
 // Delete deletes a domain by ID from the Openprovider API.
 //
 // Endpoint: DELETE https://api.openprovider.eu/v1beta/domains/{id}
-func Delete(c *client.Client, id int) error {
+func Delete(ctx context.Context, c *client.Client, id int) error {
 	path := fmt.Sprintf("/v1beta/domains/%d", id)
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s%s", c.BaseURL, path), nil)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s%s", c.BaseURL, path), nil)
 	if err != nil {
 		return err
 	}
 
-	resp, err := c.Do(req)
+	resp, err := c.Do(ctx, req)
 	if err != nil {
 		return err
 	}
@@ -36,15 +37,11 @@ func Delete(c *client.Client, id int) error {
 		_ = resp.Body.Close()
 	}()
 
+	// Non-zero API error codes are surfaced by c.Do as a *client.APIError.
 	var result DeleteDomainResponse
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return err
 	}
 
-	// Check if the API returned an error code (non-zero typically indicates error)
-	if result.Code != 0 {
-		return fmt.Errorf("delete operation failed with code %d", result.Code)
-	}
-
 	return nil
 }
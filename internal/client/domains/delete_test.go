@@ -2,6 +2,7 @@
 package domains_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/charpand/terraform-provider-openprovider/internal/client/domains"
@@ -12,7 +13,7 @@ func TestDeleteDomain(t *testing.T) {
 	apiClient := testutils.SetupTestClient()
 
 	// Delete a test domain
-	err := domains.Delete(apiClient, 123)
+	err := domains.Delete(context.Background(), apiClient, 123)
 
 	if err != nil {
 		t.Fatalf("Expected no error, got %v", err)
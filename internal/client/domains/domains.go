@@ -2,9 +2,12 @@
 package domains
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
+	"net/url"
 
 	"github.com/charpand/terraform-provider-openprovider/internal/client"
 )
@@ -45,11 +48,13 @@ type Domain struct {
 	OrderDate       string       `json:"order_date"`
 	OwnerHandle     string       `json:"owner_handle"`
 	Status          string       `json:"status"`
+	Reason          string       `json:"reason,omitempty"` // why a status failed or was rejected, if given
 	TechHandle      string       `json:"tech_handle"`
 	Nameservers     []Nameserver `json:"name_servers,omitempty"`
 	NSGroup         string       `json:"ns_group,omitempty"`
 	DnssecKeys      []DnssecKey  `json:"dnssec_keys,omitempty"`
 	IsDnssecEnabled bool         `json:"is_dnssec_enabled,omitempty"`
+	IsPrivateWhois  bool         `json:"is_private_whois_enabled"`
 	Domain          struct {
 		Name      string `json:"name"`
 		Extension string `json:"extension"`
@@ -65,17 +70,83 @@ type ListDomainsResponse struct {
 	} `json:"data"`
 }
 
-// List retrieves a list of domains from the Openprovider API.
-func List(c *client.Client) ([]Domain, error) {
+// ListOptions filters and orders the results of List and ListIter.
+// Zero-valued fields are not sent to the API.
+type ListOptions struct {
+	// DomainNamePattern matches the domain name without extension; "*" acts as a wildcard.
+	DomainNamePattern string
+	// Extension restricts results to a single extension, e.g. "com" or "co.uk".
+	Extension string
+	// Status restricts results to domains in the given status, e.g. "ACT".
+	Status string
+	// OwnerHandle restricts results to domains owned by the given customer handle.
+	OwnerHandle string
+	// NSGroupPattern matches the name of the nameserver group assigned to the domain.
+	NSGroupPattern string
+	// OrderBy is the field to order by, e.g. "domain_name" or "expiration_date".
+	OrderBy string
+	// Descending reverses the ordering selected by OrderBy.
+	Descending bool
+}
+
+// apply adds the query parameters for the options to query.
+func (o *ListOptions) apply(query url.Values) {
+	if o == nil {
+		return
+	}
+	if o.DomainNamePattern != "" {
+		query.Set("domain_name_pattern", o.DomainNamePattern)
+	}
+	if o.Extension != "" {
+		query.Set("extension", o.Extension)
+	}
+	if o.Status != "" {
+		query.Set("status", o.Status)
+	}
+	if o.OwnerHandle != "" {
+		query.Set("owner_handle", o.OwnerHandle)
+	}
+	if o.NSGroupPattern != "" {
+		query.Set("ns_group_pattern", o.NSGroupPattern)
+	}
+	if o.OrderBy != "" {
+		direction := "asc"
+		if o.Descending {
+			direction = "desc"
+		}
+		query.Set("order_by."+o.OrderBy, direction)
+	}
+}
+
+// List retrieves all domains matching opts from the Openprovider API, following pagination.
+// A nil opts lists every domain.
+//
+// Endpoint: GET https://api.openprovider.eu/v1beta/domains
+func List(ctx context.Context, c *client.Client, opts *ListOptions) ([]Domain, error) {
+	return client.Collect(ListIter(ctx, c, opts))
+}
+
+// ListIter returns an iterator over all domains matching opts, fetching pages of c.PageSize lazily.
+// Breaking out of the loop stops fetching further pages.
+func ListIter(ctx context.Context, c *client.Client, opts *ListOptions) iter.Seq2[Domain, error] {
+	return client.Paginate(ctx, c.PageSize, func(ctx context.Context, limit, offset int) ([]Domain, int, error) {
+		return listPage(ctx, c, opts, limit, offset)
+	})
+}
+
+// listPage retrieves a single page of domains.
+func listPage(ctx context.Context, c *client.Client, opts *ListOptions, limit, offset int) ([]Domain, int, error) {
 	path := "/v1beta/domains"
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s%s", c.BaseURL, path), nil)
+	query := client.PageQuery(limit, offset)
+	opts.apply(query)
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s%s?%s", c.BaseURL, path, query.Encode()), nil)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
-	resp, err := c.Do(req)
+	resp, err := c.Do(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	defer func() {
@@ -84,7 +155,7 @@ func List(c *client.Client) ([]Domain, error) {
 
 	var results ListDomainsResponse
 	if err := json.NewDecoder(resp.Body).Decode(&results); err != nil {
-		return nil, err
+		return nil, 0, err
 	}
-	return results.Data.Results, nil
+	return results.Data.Results, results.Data.Total, nil
 }
@@ -2,8 +2,12 @@
 package domains_test
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/charpand/terraform-provider-openprovider/internal/client"
 	"github.com/charpand/terraform-provider-openprovider/internal/client/domains"
 	"github.com/charpand/terraform-provider-openprovider/internal/testutils"
 )
@@ -11,7 +15,7 @@ import (
 func TestListDomains(t *testing.T) {
 	apiClient := testutils.SetupTestClient()
 
-	resp, err := domains.List(apiClient)
+	resp, err := domains.List(context.Background(), apiClient, nil)
 
 	if err != nil {
 		t.Fatalf("Expected no error, got %v", err)
@@ -21,3 +25,39 @@ func TestListDomains(t *testing.T) {
 		t.Log("Note: No domains returned by mock server (check your swagger examples)")
 	}
 }
+
+func TestListDomainsFollowsPagination(t *testing.T) {
+	var offsets []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		offsets = append(offsets, r.URL.Query().Get("offset"))
+		if r.URL.Query().Get("limit") != "2" {
+			t.Errorf("Expected limit=2, got %q", r.URL.Query().Get("limit"))
+		}
+		switch r.URL.Query().Get("offset") {
+		case "0":
+			_, _ = w.Write([]byte(`{"code": 0, "data": {"total": 3, "results": [{"id": 1}, {"id": 2}]}}`))
+		default:
+			_, _ = w.Write([]byte(`{"code": 0, "data": {"total": 3, "results": [{"id": 3}]}}`))
+		}
+	}))
+	defer server.Close()
+
+	apiClient := client.NewClient(client.Config{
+		BaseURL:    server.URL,
+		Token:      "test-token",
+		PageSize:   2,
+		HTTPClient: server.Client(),
+	})
+
+	resp, err := domains.List(context.Background(), apiClient, nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(resp) != 3 || resp[2].ID != 3 {
+		t.Errorf("Expected 3 domains across pages, got %+v", resp)
+	}
+	if len(offsets) != 2 || offsets[0] != "0" || offsets[1] != "2" {
+		t.Errorf("Expected offsets [0 2], got %v", offsets)
+	}
+}
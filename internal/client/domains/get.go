@@ -2,6 +2,7 @@
 package domains
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -18,14 +19,14 @@ type GetDomainResponse struct {
 // Get retrieves a single domain by ID from the Openprovider API.
 //
 // Endpoint: GET https://api.openprovider.eu/v1beta/domains/{id}
-func Get(c *client.Client, id int) (*Domain, error) {
+func Get(ctx context.Context, c *client.Client, id int) (*Domain, error) {
 	path := fmt.Sprintf("/v1beta/domains/%d", id)
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s%s", c.BaseURL, path), nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s%s", c.BaseURL, path), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := c.Do(req)
+	resp, err := c.Do(ctx, req)
 	if resp != nil {
 		defer func() {
 			_ = resp.Body.Close()
@@ -0,0 +1,43 @@
+// Package domains provides functionality for working with domains.
+package domains
+
+import (
+	"context"
+	"strings"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client"
+	"github.com/charpand/terraform-provider-openprovider/internal/domainname"
+)
+
+// GetByName retrieves a single domain by its fully qualified name, e.g. "example.com",
+// "example.co.uk" or "münchen.de". It returns nil without an error if the account has
+// no such domain.
+//
+// The lookup is filtered server-side on name and extension, so it costs a single
+// request regardless of how many domains the account holds.
+//
+// Endpoint: GET https://api.openprovider.eu/v1beta/domains?domain_name_pattern={name}&extension={extension}
+func GetByName(ctx context.Context, c *client.Client, domainName string) (*Domain, error) {
+	parsed, err := domainname.Parse(domainName)
+	if err != nil {
+		return nil, err
+	}
+	name, extension := parsed.Label, parsed.Extension
+
+	opts := &ListOptions{
+		DomainNamePattern: name,
+		Extension:         extension,
+	}
+	for domain, err := range ListIter(ctx, c, opts) {
+		if err != nil {
+			return nil, err
+		}
+
+		// The pattern filter may match more loosely than an exact comparison.
+		if strings.EqualFold(domain.Domain.Name, name) && strings.EqualFold(domain.Domain.Extension, extension) {
+			return &domain, nil
+		}
+	}
+
+	return nil, nil
+}
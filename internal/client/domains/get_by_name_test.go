@@ -0,0 +1,129 @@
+// Package domains_test contains tests for the domains package.
+package domains_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client"
+	"github.com/charpand/terraform-provider-openprovider/internal/client/domains"
+)
+
+func newQueryRecordingClient(t *testing.T, body string, queries *[]url.Values) *client.Client {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*queries = append(*queries, r.URL.Query())
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	return client.NewClient(client.Config{
+		BaseURL:    server.URL,
+		Token:      "test-token",
+		HTTPClient: server.Client(),
+	})
+}
+
+func TestGetByName(t *testing.T) {
+	var queries []url.Values
+	apiClient := newQueryRecordingClient(t, `{"code": 0, "data": {"total": 2, "results": [
+		{"id": 1, "domain": {"name": "example-shop", "extension": "co.uk"}},
+		{"id": 2, "domain": {"name": "example", "extension": "co.uk"}}
+	]}}`, &queries)
+
+	domain, err := domains.GetByName(context.Background(), apiClient, "Example.co.uk")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if domain == nil || domain.ID != 2 {
+		t.Fatalf("Expected domain 2, got %+v", domain)
+	}
+
+	if len(queries) != 1 {
+		t.Fatalf("Expected a single request, got %d", len(queries))
+	}
+	if got := queries[0].Get("domain_name_pattern"); got != "example" {
+		t.Errorf("Expected domain_name_pattern=example, got %q", got)
+	}
+	if got := queries[0].Get("extension"); got != "co.uk" {
+		t.Errorf("Expected extension=co.uk, got %q", got)
+	}
+}
+
+func TestGetByNameNotFound(t *testing.T) {
+	var queries []url.Values
+	apiClient := newQueryRecordingClient(t, `{"code": 0, "data": {"total": 0, "results": []}}`, &queries)
+
+	domain, err := domains.GetByName(context.Background(), apiClient, "missing.com")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if domain != nil {
+		t.Errorf("Expected nil domain, got %+v", domain)
+	}
+}
+
+func TestGetByNameIDN(t *testing.T) {
+	var queries []url.Values
+	apiClient := newQueryRecordingClient(t, `{"code": 0, "data": {"total": 1, "results": [
+		{"id": 3, "domain": {"name": "xn--mnchen-3ya", "extension": "de"}}
+	]}}`, &queries)
+
+	domain, err := domains.GetByName(context.Background(), apiClient, "München.de")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if domain == nil || domain.ID != 3 {
+		t.Fatalf("Expected domain 3, got %+v", domain)
+	}
+	if got := queries[0].Get("domain_name_pattern"); got != "xn--mnchen-3ya" {
+		t.Errorf("Expected domain_name_pattern=xn--mnchen-3ya, got %q", got)
+	}
+}
+
+func TestGetByNameInvalid(t *testing.T) {
+	apiClient := client.NewClient(client.Config{Token: "test-token"})
+
+	for _, name := range []string{"", "example", ".com", "example.", "co.uk", "www.example.com"} {
+		if _, err := domains.GetByName(context.Background(), apiClient, name); err == nil {
+			t.Errorf("Expected error for %q", name)
+		}
+	}
+}
+
+func TestListDomainsWithOptions(t *testing.T) {
+	var queries []url.Values
+	apiClient := newQueryRecordingClient(t, `{"code": 0, "data": {"total": 0, "results": []}}`, &queries)
+
+	_, err := domains.List(context.Background(), apiClient, &domains.ListOptions{
+		DomainNamePattern: "example*",
+		Extension:         "com",
+		Status:            "ACT",
+		OwnerHandle:       "XX123456-XX",
+		NSGroupPattern:    "my-group",
+		OrderBy:           "expiration_date",
+		Descending:        true,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := map[string]string{
+		"domain_name_pattern":      "example*",
+		"extension":                "com",
+		"status":                   "ACT",
+		"owner_handle":             "XX123456-XX",
+		"ns_group_pattern":         "my-group",
+		"order_by.expiration_date": "desc",
+		"limit":                    "100",
+		"offset":                   "0",
+	}
+	for key, value := range expected {
+		if got := queries[0].Get(key); got != value {
+			t.Errorf("Expected %s=%q, got %q", key, value, got)
+		}
+	}
+}
@@ -2,6 +2,7 @@
 package domains_test
 
 import (
+	"context"
 	"net/http"
 	"os"
 	"testing"
@@ -16,7 +17,7 @@ func TestGetDomain(t *testing.T) {
 
 	// Replace 123 with an example ID that exists in your OpenAPI examples/mock
 	// The Prism mock server will return sample data based on the swagger examples.
-	domain, err := domains.Get(apiClient, 123)
+	domain, err := domains.Get(context.Background(), apiClient, 123)
 
 	if err != nil {
 		t.Fatalf("Expected no error, got %v", err)
@@ -63,7 +64,7 @@ func TestGetDomainWithError(t *testing.T) {
 	}
 	apiClient := client.NewClient(config)
 
-	domain, err := domains.Get(apiClient, 999999)
+	domain, err := domains.Get(context.Background(), apiClient, 999999)
 
 	if err == nil {
 		t.Fatal("Expected error for 404 status code, got nil")
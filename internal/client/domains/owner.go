@@ -0,0 +1,45 @@
+// Package domains provides functionality for working with domains.
+package domains
+
+import "strings"
+
+// tradeRequired lists extensions whose registries only change the registrant of a
+// domain through a trade, a paid procedure in which the new registrant takes over
+// the domain. Extensions below them are covered as well.
+var tradeRequired = map[string]bool{
+	"be": true,
+	"eu": true,
+	"fr": true,
+	"it": true,
+	"nl": true,
+}
+
+// RequiresTrade reports whether changing the owner of domains under extension,
+// e.g. "nl" or "com", needs a Trade rather than an Update of the owner handle.
+func RequiresTrade(extension string) bool {
+	extension = strings.ToLower(extension)
+	for {
+		if tradeRequired[extension] {
+			return true
+		}
+		_, parent, ok := strings.Cut(extension, ".")
+		if !ok {
+			return false
+		}
+		extension = parent
+	}
+}
+
+// OwnerChangeLocksTransfers reports whether changing the owner of domains under
+// extension locks them against transfers to another registrar for 60 days, as the
+// ICANN Transfer Policy requires for generic extensions such as "com" or "shop".
+// Country-code extensions, whose top-level label has two letters, are not
+// covered by the policy. Internationalized extensions count as generic, as their
+// ASCII form does not tell country codes apart.
+func OwnerChangeLocksTransfers(extension string) bool {
+	extension = strings.ToLower(extension)
+	if i := strings.LastIndex(extension, "."); i >= 0 {
+		extension = extension[i+1:]
+	}
+	return len(extension) > 2
+}
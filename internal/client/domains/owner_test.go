@@ -0,0 +1,42 @@
+// Package domains_test contains tests for the domains package.
+package domains_test
+
+import (
+	"testing"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client/domains"
+)
+
+func TestRequiresTrade(t *testing.T) {
+	tests := map[string]bool{
+		"nl":     true,
+		"EU":     true,
+		"co.it":  true,
+		"com":    false,
+		"de":     false,
+		"nl.com": false,
+	}
+
+	for extension, want := range tests {
+		if got := domains.RequiresTrade(extension); got != want {
+			t.Errorf("RequiresTrade(%q) = %v, want %v", extension, got, want)
+		}
+	}
+}
+
+func TestOwnerChangeLocksTransfers(t *testing.T) {
+	tests := map[string]bool{
+		"com":      true,
+		"SHOP":     true,
+		"uk.com":   true,
+		"xn--p1ai": true,
+		"de":       false,
+		"co.uk":    false,
+	}
+
+	for extension, want := range tests {
+		if got := domains.OwnerChangeLocksTransfers(extension); got != want {
+			t.Errorf("OwnerChangeLocksTransfers(%q) = %v, want %v", extension, got, want)
+		}
+	}
+}
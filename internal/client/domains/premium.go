@@ -0,0 +1,43 @@
+// Package domains provides functionality for working with domains.
+package domains
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client"
+)
+
+// PremiumPrice returns the registration price charged to the reseller account
+// for the named domain, such as "example.shop", if its registry sells it at a
+// premium price, and nil otherwise. Registering a premium domain requires
+// CreateDomainRequest.AcceptPremiumFee to cover this price.
+//
+// Endpoint: POST https://api.openprovider.eu/v1beta/domains/check
+func PremiumPrice(ctx context.Context, c *client.Client, name string) (*Amount, error) {
+	results, err := Check(ctx, c, []string{name}, true)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) != 1 {
+		return nil, fmt.Errorf("the check returned %d results for domain %s", len(results), name)
+	}
+
+	result := results[0]
+	if !result.IsPremium {
+		return nil, nil
+	}
+	if result.Price == nil {
+		return nil, fmt.Errorf("the check returned no price for premium domain %s", name)
+	}
+
+	price := result.Price.Reseller
+	return &price, nil
+}
+
+// Covers reports whether a accepts paying price: both are in the same currency,
+// compared case-insensitively, and a is at least price.
+func (a Amount) Covers(price Amount) bool {
+	return strings.EqualFold(a.Currency, price.Currency) && a.Price >= price.Price
+}
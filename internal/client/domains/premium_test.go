@@ -0,0 +1,88 @@
+// Package domains_test contains tests for the domains package.
+package domains_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client"
+	"github.com/charpand/terraform-provider-openprovider/internal/client/domains"
+)
+
+func TestPremiumPrice(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		want     *domains.Amount
+		wantErr  bool
+	}{
+		{
+			name: "Premium",
+			response: `{"code": 0, "data": {"results": [{"domain": "example.shop", "status": "free", "is_premium": true,
+				"price": {"product": {"currency": "USD", "price": 300}, "reseller": {"currency": "EUR", "price": 275.5}}}]}}`,
+			want: &domains.Amount{Currency: "EUR", Price: 275.5},
+		},
+		{
+			name: "Regular",
+			response: `{"code": 0, "data": {"results": [{"domain": "example.shop", "status": "free", "is_premium": false,
+				"price": {"product": {"currency": "USD", "price": 30}, "reseller": {"currency": "EUR", "price": 27.5}}}]}}`,
+		},
+		{
+			name:     "Premium without price",
+			response: `{"code": 0, "data": {"results": [{"domain": "example.shop", "status": "free", "is_premium": true}]}}`,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(tt.response))
+			}))
+			defer server.Close()
+
+			apiClient := client.NewClient(client.Config{
+				BaseURL:    server.URL,
+				Token:      "test-token",
+				HTTPClient: server.Client(),
+			})
+
+			got, err := domains.PremiumPrice(context.Background(), apiClient, "example.shop")
+			if tt.wantErr {
+				if err == nil {
+					t.Error("Expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if (got == nil) != (tt.want == nil) || (got != nil && *got != *tt.want) {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestAmountCovers(t *testing.T) {
+	price := domains.Amount{Currency: "EUR", Price: 275.5}
+
+	tests := []struct {
+		accepted domains.Amount
+		want     bool
+	}{
+		{domains.Amount{Currency: "EUR", Price: 275.5}, true},
+		{domains.Amount{Currency: "EUR", Price: 300}, true},
+		{domains.Amount{Currency: "eur", Price: 300}, true},
+		{domains.Amount{Currency: "EUR", Price: 275}, false},
+		{domains.Amount{Currency: "USD", Price: 1000}, false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.accepted.Covers(price); got != tt.want {
+			t.Errorf("%v.Covers(%v) = %v, want %v", tt.accepted, price, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+// Package domains provides functionality for working with domains.
+package domains
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client"
+)
+
+// Operations that can be priced with GetPrice.
+const (
+	OperationCreate   = "create"
+	OperationTransfer = "transfer"
+	OperationRenew    = "renew"
+	OperationRestore  = "restore"
+)
+
+// Amount is a price in a currency.
+type Amount struct {
+	Currency string  `json:"currency"`
+	Price    float64 `json:"price"`
+}
+
+// DomainPrice represents the price of an operation on a domain.
+type DomainPrice struct {
+	IsPremium bool `json:"is_premium"`
+	Price     struct {
+		// Product is the price in the registry's currency.
+		Product Amount `json:"product"`
+		// Reseller is the price charged to the reseller account, in its currency.
+		Reseller Amount `json:"reseller"`
+	} `json:"price"`
+}
+
+// GetDomainPriceResponse represents a response for a domain price.
+type GetDomainPriceResponse struct {
+	Code int         `json:"code"`
+	Data DomainPrice `json:"data"`
+}
+
+// GetPrice retrieves the price of an operation, such as OperationRenew, for
+// period years on the domain name.extension.
+//
+// Endpoint: GET https://api.openprovider.eu/v1beta/domains/prices
+func GetPrice(ctx context.Context, c *client.Client, name, extension, operation string, period int) (*DomainPrice, error) {
+	query := url.Values{}
+	query.Set("domain.name", name)
+	query.Set("domain.extension", extension)
+	query.Set("operation", operation)
+	query.Set("period", strconv.Itoa(period))
+
+	path := "/v1beta/domains/prices"
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s%s?%s", c.BaseURL, path, query.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.Do(ctx, req)
+	if resp != nil {
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var result GetDomainPriceResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return &result.Data, nil
+}
@@ -0,0 +1,64 @@
+// Package domains provides functionality for working with domains.
+package domains
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client"
+)
+
+// RenewDomainRequest represents a request to renew a domain.
+type RenewDomainRequest struct {
+	Period int `json:"period"`
+}
+
+// RenewDomainResponse represents a response for renewing a domain.
+type RenewDomainResponse struct {
+	Code int `json:"code"`
+	Data struct {
+		Status string `json:"status"`
+	} `json:"data"`
+}
+
+// Renew extends the registration of a domain by period years. The renewal is
+// billed to the reseller account.
+//
+// Endpoint: POST https://api.openprovider.eu/v1beta/domains/{id}/renew
+func Renew(ctx context.Context, c *client.Client, id int, period int) error {
+	if period < 1 {
+		return fmt.Errorf("invalid renewal period: %d", period)
+	}
+
+	body, err := json.Marshal(RenewDomainRequest{Period: period})
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("/v1beta/domains/%d/renew", id)
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s%s", c.BaseURL, path), bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.Do(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	// Non-zero API error codes are surfaced by c.Do as a *client.APIError.
+	var result RenewDomainResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+// Package domains_test contains tests for the domains package.
+package domains_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client"
+	"github.com/charpand/terraform-provider-openprovider/internal/client/domains"
+)
+
+func TestRenewDomain(t *testing.T) {
+	var method, path string
+	var body domains.RenewDomainRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		_, _ = w.Write([]byte(`{"code": 0, "data": {"status": "ACT"}}`))
+	}))
+	defer server.Close()
+
+	apiClient := client.NewClient(client.Config{
+		BaseURL:    server.URL,
+		Token:      "test-token",
+		HTTPClient: server.Client(),
+	})
+
+	if err := domains.Renew(context.Background(), apiClient, 123, 2); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if method != "POST" || path != "/v1beta/domains/123/renew" {
+		t.Errorf("Unexpected request: %s %s", method, path)
+	}
+	if body.Period != 2 {
+		t.Errorf("Expected period 2, got %d", body.Period)
+	}
+
+	if err := domains.Renew(context.Background(), apiClient, 123, 0); err == nil {
+		t.Error("Expected an error for a zero period")
+	}
+}
+
+func TestGetPrice(t *testing.T) {
+	var queries []url.Values
+	apiClient := newQueryRecordingClient(t, `{"code": 0, "data": {"is_premium": false, "price": {
+		"product": {"currency": "USD", "price": 10.5},
+		"reseller": {"currency": "EUR", "price": 9.75}
+	}}}`, &queries)
+
+	price, err := domains.GetPrice(context.Background(), apiClient, "example", "com", domains.OperationRenew, 2)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if price.Price.Reseller.Price != 9.75 || price.Price.Reseller.Currency != "EUR" {
+		t.Errorf("Unexpected reseller price: %+v", price.Price.Reseller)
+	}
+
+	want := url.Values{
+		"domain.name":      {"example"},
+		"domain.extension": {"com"},
+		"operation":        {"renew"},
+		"period":           {"2"},
+	}
+	if len(queries) != 1 || queries[0].Encode() != want.Encode() {
+		t.Errorf("Unexpected query: %v", queries)
+	}
+}
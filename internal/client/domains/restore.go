@@ -0,0 +1,49 @@
+// Package domains provides functionality for working with domains.
+package domains
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client"
+)
+
+// RestoreDomainResponse represents a response for restoring a domain.
+type RestoreDomainResponse struct {
+	Code int `json:"code"`
+	Data struct {
+		Status string `json:"status"`
+	} `json:"data"`
+}
+
+// Restore brings a deleted or expired domain back out of quarantine. Restoring a
+// domain is billed to the reseller account; use GetPrice with OperationRestore to
+// quote it beforehand.
+//
+// Endpoint: POST https://api.openprovider.eu/v1beta/domains/{id}/restore
+func Restore(ctx context.Context, c *client.Client, id int) error {
+	path := fmt.Sprintf("/v1beta/domains/%d/restore", id)
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s%s", c.BaseURL, path), nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.Do(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	// Non-zero API error codes are surfaced by c.Do as a *client.APIError.
+	var result RestoreDomainResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return err
+	}
+
+	return nil
+}
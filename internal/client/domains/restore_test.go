@@ -0,0 +1,52 @@
+// Package domains_test contains tests for the domains package.
+package domains_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client"
+	"github.com/charpand/terraform-provider-openprovider/internal/client/domains"
+)
+
+func TestRestoreDomain(t *testing.T) {
+	var method, path string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		_, _ = w.Write([]byte(`{"code": 0, "data": {"status": "ACT"}}`))
+	}))
+	defer server.Close()
+
+	apiClient := client.NewClient(client.Config{
+		BaseURL:    server.URL,
+		Token:      "test-token",
+		HTTPClient: server.Client(),
+	})
+
+	if err := domains.Restore(context.Background(), apiClient, 123); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if method != "POST" || path != "/v1beta/domains/123/restore" {
+		t.Errorf("Unexpected request: %s %s", method, path)
+	}
+}
+
+func TestRestoreDomainNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"code": 320, "desc": "Domain not found"}`))
+	}))
+	defer server.Close()
+
+	apiClient := client.NewClient(client.Config{
+		BaseURL:    server.URL,
+		Token:      "test-token",
+		HTTPClient: server.Client(),
+	})
+
+	if err := domains.Restore(context.Background(), apiClient, 123); !client.IsNotFound(err) {
+		t.Errorf("Expected a not found error, got %v", err)
+	}
+}
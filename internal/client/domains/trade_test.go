@@ -0,0 +1,48 @@
+// Package domains_test contains tests for the domains package.
+package domains_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client"
+	"github.com/charpand/terraform-provider-openprovider/internal/client/domains"
+)
+
+func TestTradeDomain(t *testing.T) {
+	var method, path string
+	var body domains.TradeDomainRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		_, _ = w.Write([]byte(`{"code": 0, "data": {"id": 123, "status": "REQ"}}`))
+	}))
+	defer server.Close()
+
+	apiClient := client.NewClient(client.Config{
+		BaseURL:    server.URL,
+		Token:      "test-token",
+		HTTPClient: server.Client(),
+	})
+
+	req := &domains.TradeDomainRequest{OwnerHandle: "NEW123"}
+	req.Domain.Name = "example"
+	req.Domain.Extension = "nl"
+
+	domain, err := domains.Trade(context.Background(), apiClient, req)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if method != "POST" || path != "/v1beta/domains/trade" {
+		t.Errorf("Unexpected request: %s %s", method, path)
+	}
+	if body.Domain.Name != "example" || body.Domain.Extension != "nl" || body.OwnerHandle != "NEW123" {
+		t.Errorf("Unexpected request body: %+v", body)
+	}
+	if domain.ID != 123 || domain.Status != domains.StatusRequested {
+		t.Errorf("Unexpected domain: %+v", domain)
+	}
+}
@@ -3,6 +3,7 @@ package domains
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -16,14 +17,16 @@ type TransferDomainRequest struct {
 		Name      string `json:"name"`
 		Extension string `json:"extension"`
 	} `json:"domain"`
-	AuthCode      string       `json:"auth_code"`
-	OwnerHandle   string       `json:"owner_handle"`
-	AdminHandle   string       `json:"admin_handle,omitempty"`
-	TechHandle    string       `json:"tech_handle,omitempty"`
-	BillingHandle string       `json:"billing_handle,omitempty"`
-	Autorenew     string       `json:"autorenew,omitempty"`
-	NSGroup       string       `json:"ns_group,omitempty"`
-	Nameservers   []Nameserver `json:"name_servers,omitempty"`
+	AuthCode       string          `json:"auth_code"`
+	OwnerHandle    string          `json:"owner_handle"`
+	AdminHandle    string          `json:"admin_handle,omitempty"`
+	TechHandle     string          `json:"tech_handle,omitempty"`
+	BillingHandle  string          `json:"billing_handle,omitempty"`
+	Autorenew      string          `json:"autorenew,omitempty"`
+	NSGroup        string          `json:"ns_group,omitempty"`
+	Nameservers    []Nameserver    `json:"name_servers,omitempty"`
+	IsPrivateWhois *bool           `json:"is_private_whois_enabled,omitempty"`
+	AdditionalData *AdditionalData `json:"additional_data,omitempty"`
 }
 
 // TransferDomainResponse represents a response for transferring a domain.
@@ -35,20 +38,20 @@ type TransferDomainResponse struct {
 // Transfer initiates a domain transfer via the Openprovider API.
 //
 // Endpoint: POST https://api.openprovider.eu/v1beta/domains/transfer
-func Transfer(c *client.Client, req *TransferDomainRequest) (*Domain, error) {
+func Transfer(ctx context.Context, c *client.Client, req *TransferDomainRequest) (*Domain, error) {
 	body, err := json.Marshal(req)
 	if err != nil {
 		return nil, err
 	}
 
 	path := "/v1beta/domains/transfer"
-	httpReq, err := http.NewRequest("POST", fmt.Sprintf("%s%s", c.BaseURL, path), bytes.NewBuffer(body))
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s%s", c.BaseURL, path), bytes.NewBuffer(body))
 	if err != nil {
 		return nil, err
 	}
 
 	httpReq.Header.Set("Content-Type", "application/json")
-	resp, err := c.Do(httpReq)
+	resp, err := c.Do(ctx, httpReq)
 	if resp != nil {
 		defer func() {
 			_ = resp.Body.Close()
@@ -2,6 +2,7 @@
 package domains_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/charpand/terraform-provider-openprovider/internal/client/domains"
@@ -18,7 +19,7 @@ func TestTransferDomain(t *testing.T) {
 	req.OwnerHandle = "testowner"
 	req.Autorenew = "on"
 
-	domain, err := domains.Transfer(apiClient, req)
+	domain, err := domains.Transfer(context.Background(), apiClient, req)
 
 	if err != nil {
 		t.Fatalf("Expected no error, got %v", err)
@@ -45,7 +46,7 @@ func TestTransferDomainWithNSGroup(t *testing.T) {
 	req.NSGroup = "dns-openprovider"
 	req.Autorenew = "on"
 
-	domain, err := domains.Transfer(apiClient, req)
+	domain, err := domains.Transfer(context.Background(), apiClient, req)
 
 	if err != nil {
 		t.Fatalf("Expected no error, got %v", err)
@@ -70,7 +71,7 @@ func TestTransferDomainWithImportOptions(t *testing.T) {
 	req.AuthCode = "12345678"
 	req.OwnerHandle = "testowner"
 
-	domain, err := domains.Transfer(apiClient, req)
+	domain, err := domains.Transfer(context.Background(), apiClient, req)
 
 	if err != nil {
 		t.Fatalf("Expected no error, got %v", err)
@@ -95,7 +96,7 @@ func TestTransferDomainWithNameservers(t *testing.T) {
 		{Name: "ns2.example.com"},
 	}
 
-	domain, err := domains.Transfer(apiClient, req)
+	domain, err := domains.Transfer(context.Background(), apiClient, req)
 
 	if err != nil {
 		t.Fatalf("Expected no error, got %v", err)
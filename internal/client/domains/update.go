@@ -3,6 +3,7 @@ package domains
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -12,15 +13,20 @@ import (
 
 // UpdateDomainRequest represents a request to update a domain.
 type UpdateDomainRequest struct {
-	AdminHandle     string       `json:"admin_handle,omitempty"`
-	TechHandle      string       `json:"tech_handle,omitempty"`
-	BillingHandle   string       `json:"billing_handle,omitempty"`
-	Autorenew       string       `json:"autorenew,omitempty"`
-	IsLocked        *bool        `json:"is_locked,omitempty"`
-	Nameservers     []Nameserver `json:"name_servers,omitempty"`
-	NSGroup         string       `json:"ns_group,omitempty"`
-	DnssecKeys      []DnssecKey  `json:"dnssec_keys,omitempty"`
-	IsDnssecEnabled *bool        `json:"is_dnssec_enabled,omitempty"`
+	// OwnerHandle changes the registrant. Extensions for which RequiresTrade
+	// reports true need a Trade instead.
+	OwnerHandle     string          `json:"owner_handle,omitempty"`
+	AdminHandle     string          `json:"admin_handle,omitempty"`
+	TechHandle      string          `json:"tech_handle,omitempty"`
+	BillingHandle   string          `json:"billing_handle,omitempty"`
+	Autorenew       string          `json:"autorenew,omitempty"`
+	IsLocked        *bool           `json:"is_locked,omitempty"`
+	Nameservers     []Nameserver    `json:"name_servers,omitempty"`
+	NSGroup         string          `json:"ns_group,omitempty"`
+	DnssecKeys      []DnssecKey     `json:"dnssec_keys,omitempty"`
+	IsDnssecEnabled *bool           `json:"is_dnssec_enabled,omitempty"`
+	IsPrivateWhois  *bool           `json:"is_private_whois_enabled,omitempty"`
+	AdditionalData  *AdditionalData `json:"additional_data,omitempty"`
 }
 
 // UpdateDomainResponse represents a response for updating a domain.
@@ -32,20 +38,20 @@ type UpdateDomainResponse struct {
 // Update updates an existing domain by ID via the Openprovider API.
 //
 // Endpoint: PUT https://api.openprovider.eu/v1beta/domains/{id}
-func Update(c *client.Client, id int, req *UpdateDomainRequest) (*Domain, error) {
+func Update(ctx context.Context, c *client.Client, id int, req *UpdateDomainRequest) (*Domain, error) {
 	body, err := json.Marshal(req)
 	if err != nil {
 		return nil, err
 	}
 
 	path := fmt.Sprintf("/v1beta/domains/%d", id)
-	httpReq, err := http.NewRequest("PUT", fmt.Sprintf("%s%s", c.BaseURL, path), bytes.NewBuffer(body))
+	httpReq, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s%s", c.BaseURL, path), bytes.NewBuffer(body))
 	if err != nil {
 		return nil, err
 	}
 
 	httpReq.Header.Set("Content-Type", "application/json")
-	resp, err := c.Do(httpReq)
+	resp, err := c.Do(ctx, httpReq)
 	if resp != nil {
 		defer func() {
 			_ = resp.Body.Close()
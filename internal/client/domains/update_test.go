@@ -2,6 +2,7 @@
 package domains_test
 
 import (
+	"context"
 	"net/http"
 	"os"
 	"testing"
@@ -19,7 +20,7 @@ func TestUpdateDomain(t *testing.T) {
 		Autorenew: "on",
 	}
 
-	domain, err := domains.Update(apiClient, 123, req)
+	domain, err := domains.Update(context.Background(), apiClient, 123, req)
 
 	if err != nil {
 		t.Fatalf("Expected no error, got %v", err)
@@ -48,7 +49,7 @@ func TestUpdateDomainWithNameservers(t *testing.T) {
 		},
 	}
 
-	domain, err := domains.Update(apiClient, 123, req)
+	domain, err := domains.Update(context.Background(), apiClient, 123, req)
 
 	if err != nil {
 		t.Fatalf("Expected no error, got %v", err)
@@ -80,7 +81,7 @@ func TestUpdateDomainWithDSRecords(t *testing.T) {
 		},
 	}
 
-	domain, err := domains.Update(apiClient, 123, req)
+	domain, err := domains.Update(context.Background(), apiClient, 123, req)
 
 	if err != nil {
 		t.Fatalf("Expected no error, got %v", err)
@@ -122,7 +123,7 @@ func TestUpdateDomainWithError(t *testing.T) {
 		Autorenew: "on",
 	}
 
-	domain, err := domains.Update(apiClient, 123, req)
+	domain, err := domains.Update(context.Background(), apiClient, 123, req)
 
 	if err == nil {
 		t.Fatal("Expected error for 500 status code, got nil")
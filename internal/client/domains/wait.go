@@ -0,0 +1,126 @@
+// Package domains provides functionality for working with domains.
+package domains
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client"
+)
+
+// Domain statuses watched by WaitForTransfer.
+const (
+	// StatusActive -- the domain is registered with OpenProvider
+	StatusActive = "ACT"
+	// StatusRequested -- a registration or transfer has been requested
+	StatusRequested = "REQ"
+	// StatusFailed -- the registration or transfer failed
+	StatusFailed = "FAI"
+	// StatusRejected -- the registry or the losing registrar rejected the transfer
+	StatusRejected = "REJ"
+)
+
+// DefaultWaitInterval is the time between status checks in WaitForTransfer and
+// WaitForOwnerChange.
+const DefaultWaitInterval = 30 * time.Second
+
+// TransferFailedError is returned by WaitForTransfer when a transfer fails or is
+// rejected.
+type TransferFailedError struct {
+	// Domain is the domain as last read from the API.
+	Domain *Domain
+}
+
+func (e *TransferFailedError) Error() string {
+	msg := fmt.Sprintf("transfer of domain %s.%s ended with status %s", e.Domain.Domain.Name, e.Domain.Domain.Extension, e.Domain.Status)
+	if e.Domain.Reason != "" {
+		msg += ": " + e.Domain.Reason
+	}
+	return msg
+}
+
+// WaitOptions configures WaitForTransfer and WaitForOwnerChange.
+type WaitOptions struct {
+	// Interval is the time between status checks. Defaults to DefaultWaitInterval.
+	Interval time.Duration
+	// Progress, if set, is called with the domain after every status check.
+	Progress func(*Domain)
+}
+
+// WaitForTransfer polls the domain with the given ID until its transfer completes
+// (StatusActive) and returns it. A failed or rejected transfer returns a
+// *TransferFailedError. The wait ends with the context's error when ctx is done,
+// so its deadline bounds the wait.
+//
+// Endpoint: GET https://api.openprovider.eu/v1beta/domains/{id}
+func WaitForTransfer(ctx context.Context, c *client.Client, id int, opts *WaitOptions) (*Domain, error) {
+	return poll(ctx, c, id, opts, "transfer", func(domain *Domain) (bool, error) {
+		switch domain.Status {
+		case StatusActive:
+			return true, nil
+		case StatusFailed, StatusRejected:
+			return true, &TransferFailedError{Domain: domain}
+		}
+		return false, nil
+	})
+}
+
+// WaitForOwnerChange polls the domain with the given ID until ownerHandle owns it
+// and returns it. Owner changes complete once the registrants or the registry
+// confirm them, which can take days; the wait ends with the context's error when
+// ctx is done, so its deadline bounds the wait.
+//
+// Endpoint: GET https://api.openprovider.eu/v1beta/domains/{id}
+func WaitForOwnerChange(ctx context.Context, c *client.Client, id int, ownerHandle string, opts *WaitOptions) (*Domain, error) {
+	return poll(ctx, c, id, opts, "owner change", func(domain *Domain) (bool, error) {
+		return domain.OwnerHandle == ownerHandle, nil
+	})
+}
+
+// poll reads the domain with the given ID every opts.Interval until done reports
+// that the operation it waits for has ended, and returns the domain as last read.
+func poll(ctx context.Context, c *client.Client, id int, opts *WaitOptions, operation string, done func(*Domain) (bool, error)) (*Domain, error) {
+	interval := DefaultWaitInterval
+	var progress func(*Domain)
+	if opts != nil {
+		if opts.Interval > 0 {
+			interval = opts.Interval
+		}
+		progress = opts.Progress
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var last *Domain
+	for {
+		domain, err := Get(ctx, c, id)
+		if err != nil {
+			if last != nil && ctx.Err() != nil {
+				return last, waitError(last, operation, ctx.Err())
+			}
+			return nil, err
+		}
+		last = domain
+		if progress != nil {
+			progress(domain)
+		}
+
+		if ended, err := done(domain); ended {
+			return domain, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return domain, waitError(domain, operation, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// waitError reports that waiting for the operation on domain ended with err.
+func waitError(domain *Domain, operation string, err error) error {
+	return fmt.Errorf("waiting for %s of domain %s.%s (status %s): %w",
+		operation, domain.Domain.Name, domain.Domain.Extension, domain.Status, err)
+}
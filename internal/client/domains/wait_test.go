@@ -0,0 +1,127 @@
+// Package domains_test contains tests for the domains package.
+package domains_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client"
+	"github.com/charpand/terraform-provider-openprovider/internal/client/domains"
+)
+
+// newTransferStatusClient returns a client whose domain 123 reports the given
+// statuses in turn, repeating the last one.
+func newTransferStatusClient(t *testing.T, statuses ...string) (*client.Client, *atomic.Int32) {
+	t.Helper()
+	var polls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		n := int(polls.Add(1)) - 1
+		status := statuses[min(n, len(statuses)-1)]
+		_, _ = fmt.Fprintf(w, `{"code": 0, "data": {"id": 123, "status": %q, "reason": "Transfer rejected by the losing registrar",
+			"domain": {"name": "example", "extension": "com"}}}`, status)
+	}))
+	t.Cleanup(server.Close)
+
+	return client.NewClient(client.Config{
+		BaseURL:    server.URL,
+		Token:      "test-token",
+		HTTPClient: server.Client(),
+	}), &polls
+}
+
+func TestWaitForTransfer(t *testing.T) {
+	apiClient, polls := newTransferStatusClient(t, domains.StatusRequested, domains.StatusRequested, domains.StatusActive)
+
+	var seen []string
+	domain, err := domains.WaitForTransfer(context.Background(), apiClient, 123, &domains.WaitOptions{
+		Interval: time.Millisecond,
+		Progress: func(d *domains.Domain) { seen = append(seen, d.Status) },
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if domain.Status != domains.StatusActive {
+		t.Errorf("Expected status ACT, got %s", domain.Status)
+	}
+	if polls.Load() != 3 || len(seen) != 3 {
+		t.Errorf("Expected 3 polls, got %d (progress %v)", polls.Load(), seen)
+	}
+}
+
+func TestWaitForTransferRejected(t *testing.T) {
+	apiClient, _ := newTransferStatusClient(t, domains.StatusRequested, domains.StatusRejected)
+
+	_, err := domains.WaitForTransfer(context.Background(), apiClient, 123, &domains.WaitOptions{Interval: time.Millisecond})
+
+	var failed *domains.TransferFailedError
+	if !errors.As(err, &failed) {
+		t.Fatalf("Expected a TransferFailedError, got %v", err)
+	}
+	if failed.Domain.Status != domains.StatusRejected {
+		t.Errorf("Expected status REJ, got %s", failed.Domain.Status)
+	}
+	want := "transfer of domain example.com ended with status REJ: Transfer rejected by the losing registrar"
+	if err.Error() != want {
+		t.Errorf("Expected %q, got %q", want, err.Error())
+	}
+}
+
+func TestWaitForTransferTimeout(t *testing.T) {
+	apiClient, _ := newTransferStatusClient(t, domains.StatusRequested)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	domain, err := domains.WaitForTransfer(ctx, apiClient, 123, &domains.WaitOptions{Interval: time.Millisecond})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected a deadline error, got %v", err)
+	}
+	if domain == nil || domain.Status != domains.StatusRequested {
+		t.Errorf("Expected the last known domain, got %+v", domain)
+	}
+}
+
+func TestWaitForOwnerChange(t *testing.T) {
+	var polls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		owner := "OLD123"
+		if polls.Add(1) >= 3 {
+			owner = "NEW123"
+		}
+		_, _ = fmt.Fprintf(w, `{"code": 0, "data": {"id": 123, "status": "ACT", "owner_handle": %q,
+			"domain": {"name": "example", "extension": "com"}}}`, owner)
+	}))
+	defer server.Close()
+
+	apiClient := client.NewClient(client.Config{
+		BaseURL:    server.URL,
+		Token:      "test-token",
+		HTTPClient: server.Client(),
+	})
+
+	domain, err := domains.WaitForOwnerChange(context.Background(), apiClient, 123, "NEW123", &domains.WaitOptions{Interval: time.Millisecond})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if domain.OwnerHandle != "NEW123" || polls.Load() != 3 {
+		t.Errorf("Expected the new owner after 3 polls, got %s after %d", domain.OwnerHandle, polls.Load())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err = domains.WaitForOwnerChange(ctx, apiClient, 123, "OTHER123", &domains.WaitOptions{Interval: time.Millisecond})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected a deadline error, got %v", err)
+	}
+	if want := "waiting for owner change of domain example.com"; !strings.HasPrefix(err.Error(), want) {
+		t.Errorf("Expected the error to start with %q, got %q", want, err.Error())
+	}
+}
@@ -0,0 +1,55 @@
+// Package client provides a client for interacting with the OpenProvider API.
+package client
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	// EnvironmentProduction is the live OpenProvider environment.
+	EnvironmentProduction = "production"
+
+	// EnvironmentSandbox is the OpenProvider test environment (CTE). Orders placed
+	// there are not billed.
+	EnvironmentSandbox = "sandbox"
+
+	// SandboxBaseURL -- root url for the openprovider test environment (CTE)
+	SandboxBaseURL = "https://api.cte.openprovider.eu"
+)
+
+// ErrBillableOperationNotAllowed is returned by CheckBillable when billable
+// operations are not allowed in the client's environment.
+var ErrBillableOperationNotAllowed = errors.New("billable operation not allowed")
+
+// Environments returns the names of the supported environments.
+func Environments() []string {
+	return []string{EnvironmentProduction, EnvironmentSandbox}
+}
+
+// BaseURLForEnvironment returns the API root url of the named environment. It
+// returns an empty string for an unknown environment.
+func BaseURLForEnvironment(environment string) string {
+	switch environment {
+	case EnvironmentProduction:
+		return DefaultBaseURL
+	case EnvironmentSandbox:
+		return SandboxBaseURL
+	default:
+		return ""
+	}
+}
+
+// CheckBillable returns an error wrapping ErrBillableOperationNotAllowed unless
+// billable operations were allowed for the client's environment. Operations that
+// cost money or cannot be undone, such as registering domains, ordering
+// certificates and renewals, must call it before reaching the API. With
+// DeferBillableCheck set, it returns nil.
+func (c *Client) CheckBillable(operation string) error {
+	if c.DeferBillableCheck || (c.AllowBillableOperations != "" && c.AllowBillableOperations == c.Environment) {
+		return nil
+	}
+
+	return fmt.Errorf("%w in the %s environment: %s requires allow_billable_operations = %q",
+		ErrBillableOperationNotAllowed, c.Environment, operation, c.Environment)
+}
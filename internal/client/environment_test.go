@@ -0,0 +1,91 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestNewClientEnvironment(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      Config
+		wantEnv     string
+		wantBaseURL string
+	}{
+		{"Default", Config{}, EnvironmentProduction, DefaultBaseURL},
+		{"Production", Config{Environment: EnvironmentProduction}, EnvironmentProduction, DefaultBaseURL},
+		{"Sandbox", Config{Environment: EnvironmentSandbox}, EnvironmentSandbox, SandboxBaseURL},
+		{"BaseURL takes precedence", Config{Environment: EnvironmentSandbox, BaseURL: "http://localhost:4010"}, EnvironmentSandbox, "http://localhost:4010"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClient(tt.config)
+			if c.Environment != tt.wantEnv {
+				t.Errorf("Expected environment %s, got %s", tt.wantEnv, c.Environment)
+			}
+			if c.BaseURL != tt.wantBaseURL {
+				t.Errorf("Expected BaseURL %s, got %s", tt.wantBaseURL, c.BaseURL)
+			}
+		})
+	}
+}
+
+func TestCheckBillable(t *testing.T) {
+	tests := []struct {
+		name      string
+		config    Config
+		wantError bool
+	}{
+		{"Not allowed by default", Config{}, true},
+		{"Allowed in production", Config{AllowBillableOperations: EnvironmentProduction}, false},
+		{"Allowed in sandbox", Config{Environment: EnvironmentSandbox, AllowBillableOperations: EnvironmentSandbox}, false},
+		{"Sandbox permission does not apply to production", Config{AllowBillableOperations: EnvironmentSandbox}, true},
+		{"Production permission does not apply to sandbox", Config{Environment: EnvironmentSandbox, AllowBillableOperations: EnvironmentProduction}, true},
+		{"Deferred", Config{DeferBillableCheck: true}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewClient(tt.config).CheckBillable("domain registration")
+			if (err != nil) != tt.wantError {
+				t.Fatalf("Expected error=%v, got %v", tt.wantError, err)
+			}
+			if err != nil && !errors.Is(err, ErrBillableOperationNotAllowed) {
+				t.Errorf("Expected ErrBillableOperationNotAllowed, got %v", err)
+			}
+		})
+	}
+}
+
+func TestDoMarksSandboxErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"code": 320, "desc": "Domain not found"}`))
+	}))
+	defer server.Close()
+
+	for _, env := range Environments() {
+		c := NewClient(Config{
+			Environment: env,
+			BaseURL:     server.URL,
+			Token:       "token",
+			HTTPClient:  server.Client(),
+		})
+
+		req, _ := http.NewRequest("GET", server.URL+"/v1beta/domains/1", nil)
+		_, err := c.Do(context.Background(), req)
+		if !IsNotFound(err) {
+			t.Fatalf("Expected a not found error, got %v", err)
+		}
+
+		marked := strings.HasPrefix(err.Error(), "[sandbox] ")
+		if marked != (env == EnvironmentSandbox) {
+			t.Errorf("Unexpected sandbox marker in %s environment: %v", env, err)
+		}
+	}
+}
@@ -0,0 +1,193 @@
+// Package client provides a client for interacting with the OpenProvider API.
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// maxErrorBodySize limits how much of a non-JSON error body is kept as the description.
+const maxErrorBodySize = 512
+
+// FieldError describes a validation problem reported by the API for a single request field.
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+// APIError represents an error returned by the OpenProvider API.
+//
+// It is returned for any non-2xx response as well as for 2xx responses whose
+// envelope carries a non-zero OpenProvider error code.
+type APIError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// Code is the OpenProvider error code from the response envelope (0 if absent).
+	Code int
+	// Desc is the human readable description returned by the API.
+	Desc string
+	// Fields holds any field-level validation messages found in the response data.
+	Fields []FieldError
+	// Method and Path identify the request that failed.
+	Method string
+	Path   string
+}
+
+// Error implements the error interface.
+func (e *APIError) Error() string {
+	var b strings.Builder
+	b.WriteString("api error")
+	if e.Method != "" || e.Path != "" {
+		fmt.Fprintf(&b, " (%s %s)", e.Method, e.Path)
+	}
+	fmt.Fprintf(&b, ": status %d", e.StatusCode)
+	if e.Code != 0 {
+		fmt.Fprintf(&b, ", code %d", e.Code)
+	}
+	if e.Desc != "" {
+		fmt.Fprintf(&b, ": %s", e.Desc)
+	}
+	for _, f := range e.Fields {
+		if f.Field != "" {
+			fmt.Fprintf(&b, "; %s: %s", f.Field, f.Message)
+		} else {
+			fmt.Fprintf(&b, "; %s", f.Message)
+		}
+	}
+	return b.String()
+}
+
+// IsNotFound reports whether err is an APIError for a 404 response.
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// errorEnvelope is the common OpenProvider response envelope.
+type errorEnvelope struct {
+	Code *int            `json:"code"`
+	Desc string          `json:"desc"`
+	Data json.RawMessage `json:"data"`
+}
+
+// checkResponse inspects resp and returns an *APIError if it represents a failure.
+// The response body is buffered so that callers can still decode it on success.
+func checkResponse(resp *http.Response) error {
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	var envelope errorEnvelope
+	isEnvelope := json.Unmarshal(body, &envelope) == nil
+
+	success := resp.StatusCode >= 200 && resp.StatusCode < 300
+	if success && (!isEnvelope || envelope.Code == nil || *envelope.Code == 0) {
+		return nil
+	}
+
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+	}
+	if resp.Request != nil {
+		apiErr.Method = resp.Request.Method
+		if resp.Request.URL != nil {
+			apiErr.Path = resp.Request.URL.Path
+		}
+	}
+
+	if isEnvelope {
+		if envelope.Code != nil {
+			apiErr.Code = *envelope.Code
+		}
+		apiErr.Desc = envelope.Desc
+		apiErr.Fields = parseFieldErrors(envelope.Data)
+	} else {
+		desc := strings.TrimSpace(string(body))
+		if len(desc) > maxErrorBodySize {
+			desc = desc[:maxErrorBodySize] + "..."
+		}
+		apiErr.Desc = desc
+	}
+
+	return apiErr
+}
+
+// parseFieldErrors extracts field-level messages from the envelope data.
+// The API reports these either as an object keyed by field name or as a list of
+// objects carrying a field and a description.
+func parseFieldErrors(data json.RawMessage) []FieldError {
+	if len(data) == 0 {
+		return nil
+	}
+
+	var byField map[string]json.RawMessage
+	if err := json.Unmarshal(data, &byField); err == nil {
+		fields := make([]FieldError, 0, len(byField))
+		for name, raw := range byField {
+			for _, msg := range messages(raw) {
+				fields = append(fields, FieldError{Field: name, Message: msg})
+			}
+		}
+		sort.Slice(fields, func(i, j int) bool {
+			if fields[i].Field != fields[j].Field {
+				return fields[i].Field < fields[j].Field
+			}
+			return fields[i].Message < fields[j].Message
+		})
+		return fields
+	}
+
+	var list []struct {
+		Field   string `json:"field"`
+		Name    string `json:"name"`
+		Desc    string `json:"desc"`
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal(data, &list); err == nil {
+		var fields []FieldError
+		for _, item := range list {
+			field := item.Field
+			if field == "" {
+				field = item.Name
+			}
+			msg := item.Desc
+			if msg == "" {
+				msg = item.Message
+			}
+			if msg == "" {
+				continue
+			}
+			fields = append(fields, FieldError{Field: field, Message: msg})
+		}
+		return fields
+	}
+
+	return nil
+}
+
+// messages returns the string messages contained in a JSON string or array of strings.
+func messages(raw json.RawMessage) []string {
+	var single string
+	if err := json.Unmarshal(raw, &single); err == nil {
+		if single == "" {
+			return nil
+		}
+		return []string{single}
+	}
+
+	var many []string
+	if err := json.Unmarshal(raw, &many); err == nil {
+		return many
+	}
+
+	return nil
+}
@@ -0,0 +1,130 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func newStaticClient(status int, body string) *Client {
+	hc := &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: status,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     make(http.Header),
+			Request:    req,
+		}, nil
+	})}
+	return NewClient(Config{
+		Token:      "valid-token",
+		Retry:      &RetryPolicy{},
+		HTTPClient: hc,
+	})
+}
+
+func TestDoReturnsAPIError(t *testing.T) {
+	t.Run("Error envelope on non-2xx", func(t *testing.T) {
+		c := newStaticClient(http.StatusNotFound, `{"code": 320, "desc": "Handle not found", "data": null}`)
+
+		req, _ := http.NewRequest("GET", "http://example.com/v1beta/customers/XX123456-XX", nil)
+		resp, err := c.Do(context.Background(), req)
+
+		if resp != nil {
+			t.Error("Expected nil response on error")
+		}
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) {
+			t.Fatalf("Expected *APIError, got %T: %v", err, err)
+		}
+		if apiErr.StatusCode != http.StatusNotFound {
+			t.Errorf("Expected status 404, got %d", apiErr.StatusCode)
+		}
+		if apiErr.Code != 320 {
+			t.Errorf("Expected code 320, got %d", apiErr.Code)
+		}
+		if apiErr.Desc != "Handle not found" {
+			t.Errorf("Expected desc 'Handle not found', got %q", apiErr.Desc)
+		}
+		if apiErr.Method != "GET" || apiErr.Path != "/v1beta/customers/XX123456-XX" {
+			t.Errorf("Unexpected request info: %s %s", apiErr.Method, apiErr.Path)
+		}
+		if !IsNotFound(err) {
+			t.Error("Expected IsNotFound to be true")
+		}
+		if !strings.Contains(err.Error(), "Handle not found") {
+			t.Errorf("Expected error message to contain the API description, got %q", err.Error())
+		}
+	})
+
+	t.Run("Non-zero code on 2xx", func(t *testing.T) {
+		c := newStaticClient(http.StatusOK, `{"code": 399, "desc": "Domain not available", "data": {}}`)
+
+		req, _ := http.NewRequest("POST", "http://example.com/v1beta/domains", nil)
+		_, err := c.Do(context.Background(), req)
+
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) {
+			t.Fatalf("Expected *APIError, got %T: %v", err, err)
+		}
+		if apiErr.Code != 399 {
+			t.Errorf("Expected code 399, got %d", apiErr.Code)
+		}
+		if IsNotFound(err) {
+			t.Error("Expected IsNotFound to be false")
+		}
+	})
+
+	t.Run("Field-level validation messages", func(t *testing.T) {
+		c := newStaticClient(http.StatusBadRequest, `{"code": 500, "desc": "Validation failed", "data": {"email": "invalid email", "phone.area_code": ["required"]}}`)
+
+		req, _ := http.NewRequest("POST", "http://example.com/v1beta/customers", nil)
+		_, err := c.Do(context.Background(), req)
+
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) {
+			t.Fatalf("Expected *APIError, got %T: %v", err, err)
+		}
+		if len(apiErr.Fields) != 2 {
+			t.Fatalf("Expected 2 field errors, got %d: %v", len(apiErr.Fields), apiErr.Fields)
+		}
+		if apiErr.Fields[0].Field != "email" || apiErr.Fields[0].Message != "invalid email" {
+			t.Errorf("Unexpected first field error: %+v", apiErr.Fields[0])
+		}
+		if apiErr.Fields[1].Field != "phone.area_code" || apiErr.Fields[1].Message != "required" {
+			t.Errorf("Unexpected second field error: %+v", apiErr.Fields[1])
+		}
+	})
+
+	t.Run("Non-JSON error body", func(t *testing.T) {
+		c := newStaticClient(http.StatusBadGateway, "Bad Gateway")
+
+		req, _ := http.NewRequest("GET", "http://example.com/v1beta/domains", nil)
+		_, err := c.Do(context.Background(), req)
+
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) {
+			t.Fatalf("Expected *APIError, got %T: %v", err, err)
+		}
+		if apiErr.Desc != "Bad Gateway" {
+			t.Errorf("Expected desc 'Bad Gateway', got %q", apiErr.Desc)
+		}
+	})
+
+	t.Run("Successful response body is preserved", func(t *testing.T) {
+		c := newStaticClient(http.StatusOK, `{"code": 0, "data": {"id": 1}}`)
+
+		req, _ := http.NewRequest("GET", "http://example.com/v1beta/domains/1", nil)
+		resp, err := c.Do(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		body, _ := io.ReadAll(resp.Body)
+		if string(body) != `{"code": 0, "data": {"id": 1}}` {
+			t.Errorf("Expected body to be preserved, got %q", string(body))
+		}
+	})
+}
@@ -3,6 +3,7 @@ package nsgroups
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -25,20 +26,20 @@ type CreateNSGroupResponse struct {
 // Create creates a new nameserver group via the Openprovider API.
 //
 // Endpoint: POST https://api.openprovider.eu/v1beta/dns/nameservers/groups
-func Create(c *client.Client, req *CreateNSGroupRequest) (*NSGroup, error) {
+func Create(ctx context.Context, c *client.Client, req *CreateNSGroupRequest) (*NSGroup, error) {
 	body, err := json.Marshal(req)
 	if err != nil {
 		return nil, err
 	}
 
 	path := "/v1beta/dns/nameservers/groups"
-	httpReq, err := http.NewRequest("POST", fmt.Sprintf("%s%s", c.BaseURL, path), bytes.NewBuffer(body))
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s%s", c.BaseURL, path), bytes.NewBuffer(body))
 	if err != nil {
 		return nil, err
 	}
 
 	httpReq.Header.Set("Content-Type", "application/json")
-	resp, err := c.Do(httpReq)
+	resp, err := c.Do(ctx, httpReq)
 	if err != nil {
 		return nil, err
 	}
@@ -2,6 +2,7 @@
 package nsgroups_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/charpand/terraform-provider-openprovider/internal/client/nsgroups"
@@ -19,7 +20,7 @@ func TestCreateNSGroup(t *testing.T) {
 		},
 	}
 
-	group, err := nsgroups.Create(apiClient, req)
+	group, err := nsgroups.Create(context.Background(), apiClient, req)
 
 	if err != nil {
 		t.Fatalf("Expected no error, got %v", err)
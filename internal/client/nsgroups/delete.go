@@ -2,6 +2,7 @@
 package nsgroups
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
@@ -11,14 +12,14 @@ import (
 // Delete deletes a nameserver group by name via the Openprovider API.
 //
 // Endpoint: DELETE https://api.openprovider.eu/v1beta/dns/nameservers/groups/{ns_group}
-func Delete(c *client.Client, name string) error {
+func Delete(ctx context.Context, c *client.Client, name string) error {
 	path := fmt.Sprintf("/v1beta/dns/nameservers/groups/%s", name)
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s%s", c.BaseURL, path), nil)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s%s", c.BaseURL, path), nil)
 	if err != nil {
 		return err
 	}
 
-	resp, err := c.Do(req)
+	resp, err := c.Do(ctx, req)
 	if err != nil {
 		return err
 	}
@@ -27,9 +28,5 @@ func Delete(c *client.Client, name string) error {
 		_ = resp.Body.Close()
 	}()
 
-	if resp.StatusCode >= 400 {
-		return fmt.Errorf("failed to delete nameserver group: status code %d", resp.StatusCode)
-	}
-
 	return nil
 }
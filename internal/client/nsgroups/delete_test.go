@@ -2,6 +2,7 @@
 package nsgroups_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/charpand/terraform-provider-openprovider/internal/client/nsgroups"
@@ -11,7 +12,7 @@ import (
 func TestDeleteNSGroup(t *testing.T) {
 	apiClient := testutils.SetupTestClient()
 
-	err := nsgroups.Delete(apiClient, "test-group")
+	err := nsgroups.Delete(context.Background(), apiClient, "test-group")
 
 	if err != nil {
 		t.Fatalf("Expected no error, got %v", err)
@@ -2,6 +2,7 @@
 package nsgroups
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -25,14 +26,14 @@ type GetNSGroupByNameResponse struct {
 
 // GetByName retrieves a nameserver group by name from the Openprovider API.
 // This is useful for import operations where the name is known but not the ID.
-func GetByName(c *client.Client, name string) (*NSGroup, error) {
+func GetByName(ctx context.Context, c *client.Client, name string) (*NSGroup, error) {
 	path := fmt.Sprintf("/v1beta/dns/nameservers/groups?ns_group_pattern=%s", name)
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s%s", c.BaseURL, path), nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s%s", c.BaseURL, path), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := c.Do(req)
+	resp, err := c.Do(ctx, req)
 	if err != nil {
 		return nil, err
 	}
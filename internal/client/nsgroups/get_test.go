@@ -2,6 +2,7 @@
 package nsgroups_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/charpand/terraform-provider-openprovider/internal/client/nsgroups"
@@ -11,7 +12,7 @@ import (
 func TestGetNSGroupByName(t *testing.T) {
 	apiClient := testutils.SetupTestClient()
 
-	group, err := nsgroups.GetByName(apiClient, "test-group")
+	group, err := nsgroups.GetByName(context.Background(), apiClient, "test-group")
 
 	if err != nil {
 		// This is expected if no group matches
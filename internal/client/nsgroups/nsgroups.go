@@ -2,8 +2,10 @@
 package nsgroups
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 
 	"github.com/charpand/terraform-provider-openprovider/internal/client"
@@ -44,17 +46,31 @@ type GetNSGroupResponse struct {
 	Data NSGroup `json:"data"`
 }
 
-// List retrieves a list of nameserver groups from the Openprovider API.
-func List(c *client.Client) ([]NSGroup, error) {
+// List retrieves all nameserver groups from the Openprovider API, following pagination.
+func List(ctx context.Context, c *client.Client) ([]NSGroup, error) {
+	return client.Collect(ListIter(ctx, c))
+}
+
+// ListIter returns an iterator over all nameserver groups, fetching pages of c.PageSize lazily.
+// Breaking out of the loop stops fetching further pages.
+func ListIter(ctx context.Context, c *client.Client) iter.Seq2[NSGroup, error] {
+	return client.Paginate(ctx, c.PageSize, func(ctx context.Context, limit, offset int) ([]NSGroup, int, error) {
+		return listPage(ctx, c, limit, offset)
+	})
+}
+
+// listPage retrieves a single page of nameserver groups.
+func listPage(ctx context.Context, c *client.Client, limit, offset int) ([]NSGroup, int, error) {
 	path := "/v1beta/dns/nameservers/groups"
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s%s", c.BaseURL, path), nil)
+	query := client.PageQuery(limit, offset)
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s%s?%s", c.BaseURL, path, query.Encode()), nil)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
-	resp, err := c.Do(req)
+	resp, err := c.Do(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	defer func() {
@@ -63,21 +79,21 @@ func List(c *client.Client) ([]NSGroup, error) {
 
 	var results ListNSGroupsResponse
 	if err := json.NewDecoder(resp.Body).Decode(&results); err != nil {
-		return nil, err
+		return nil, 0, err
 	}
-	return results.Data.Results, nil
+	return results.Data.Results, results.Data.Total, nil
 }
 
 // Get retrieves a specific nameserver group by name from the Openprovider API.
 // The ns_group parameter is the group name.
-func Get(c *client.Client, name string) (*NSGroup, error) {
+func Get(ctx context.Context, c *client.Client, name string) (*NSGroup, error) {
 	path := fmt.Sprintf("/v1beta/dns/nameservers/groups/%s", name)
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s%s", c.BaseURL, path), nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s%s", c.BaseURL, path), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := c.Do(req)
+	resp, err := c.Do(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -2,6 +2,7 @@
 package nsgroups_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/charpand/terraform-provider-openprovider/internal/client/nsgroups"
@@ -11,7 +12,7 @@ import (
 func TestListNSGroups(t *testing.T) {
 	apiClient := testutils.SetupTestClient()
 
-	groups, err := nsgroups.List(apiClient)
+	groups, err := nsgroups.List(context.Background(), apiClient)
 
 	if err != nil {
 		t.Fatalf("Expected no error, got %v", err)
@@ -25,7 +26,7 @@ func TestListNSGroups(t *testing.T) {
 func TestGetNSGroup(t *testing.T) {
 	apiClient := testutils.SetupTestClient()
 
-	group, err := nsgroups.Get(apiClient, "test-group")
+	group, err := nsgroups.Get(context.Background(), apiClient, "test-group")
 
 	if err != nil {
 		t.Fatalf("Expected no error, got %v", err)
@@ -47,7 +48,7 @@ func TestCreateNSGroupPreservesIPFromAPI(t *testing.T) {
 		},
 	}
 
-	group, err := nsgroups.Create(apiClient, req)
+	group, err := nsgroups.Create(context.Background(), apiClient, req)
 	if err != nil {
 		t.Fatalf("Expected no error, got %v", err)
 	}
@@ -3,6 +3,7 @@ package nsgroups
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -25,20 +26,20 @@ type UpdateNSGroupResponse struct {
 // Update updates an existing nameserver group by name via the Openprovider API.
 //
 // Endpoint: PUT https://api.openprovider.eu/v1beta/dns/nameservers/groups/{ns_group}
-func Update(c *client.Client, name string, req *UpdateNSGroupRequest) (*NSGroup, error) {
+func Update(ctx context.Context, c *client.Client, name string, req *UpdateNSGroupRequest) (*NSGroup, error) {
 	body, err := json.Marshal(req)
 	if err != nil {
 		return nil, err
 	}
 
 	path := fmt.Sprintf("/v1beta/dns/nameservers/groups/%s", name)
-	httpReq, err := http.NewRequest("PUT", fmt.Sprintf("%s%s", c.BaseURL, path), bytes.NewBuffer(body))
+	httpReq, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s%s", c.BaseURL, path), bytes.NewBuffer(body))
 	if err != nil {
 		return nil, err
 	}
 
 	httpReq.Header.Set("Content-Type", "application/json")
-	resp, err := c.Do(httpReq)
+	resp, err := c.Do(ctx, httpReq)
 	if err != nil {
 		return nil, err
 	}
@@ -2,6 +2,7 @@
 package nsgroups_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/charpand/terraform-provider-openprovider/internal/client/nsgroups"
@@ -18,7 +19,7 @@ func TestUpdateNSGroup(t *testing.T) {
 		},
 	}
 
-	group, err := nsgroups.Update(apiClient, "test-group", req)
+	group, err := nsgroups.Update(context.Background(), apiClient, "test-group", req)
 
 	if err != nil {
 		t.Fatalf("Expected no error, got %v", err)
@@ -0,0 +1,92 @@
+// Package client provides a client for interacting with the OpenProvider API.
+package client
+
+import (
+	"context"
+	"iter"
+	"net/url"
+	"strconv"
+)
+
+const (
+	// DefaultPageSize -- number of items requested per page by list iterators
+	DefaultPageSize = 100
+	// MaxPageSize -- largest limit accepted by the OpenProvider list endpoints
+	MaxPageSize = 1000
+)
+
+// PageFunc fetches a single page of results starting at offset. It returns the
+// page's items and the total number of items reported by the API.
+type PageFunc[T any] func(ctx context.Context, limit, offset int) (results []T, total int, err error)
+
+// Paginate returns an iterator over every item of a list endpoint, fetching
+// pages of pageSize items lazily through fetch. A pageSize of zero or less uses
+// DefaultPageSize.
+//
+// Iteration stops once the reported total has been reached or the API returns a
+// short page. Breaking out of the loop stops fetching further pages. A fetch
+// error is yielded once with the zero value of T and ends the iteration.
+func Paginate[T any](ctx context.Context, pageSize int, fetch PageFunc[T]) iter.Seq2[T, error] {
+	pageSize = normalizePageSize(pageSize)
+
+	return func(yield func(T, error) bool) {
+		offset := 0
+		for {
+			if err := ctx.Err(); err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+
+			results, total, err := fetch(ctx, pageSize, offset)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range results {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			offset += len(results)
+			if len(results) == 0 || len(results) < pageSize || (total > 0 && offset >= total) {
+				return
+			}
+		}
+	}
+}
+
+// Collect drains a paginated iterator into a slice, returning the first error.
+func Collect[T any](seq iter.Seq2[T, error]) ([]T, error) {
+	var items []T
+	for item, err := range seq {
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// PageQuery returns the query parameters selecting a page of a list endpoint.
+// Callers can add endpoint-specific filters before encoding it.
+func PageQuery(limit, offset int) url.Values {
+	query := url.Values{}
+	query.Set("limit", strconv.Itoa(limit))
+	query.Set("offset", strconv.Itoa(offset))
+	return query
+}
+
+// normalizePageSize clamps a page size to the range accepted by the API.
+func normalizePageSize(pageSize int) int {
+	if pageSize <= 0 {
+		return DefaultPageSize
+	}
+	if pageSize > MaxPageSize {
+		return MaxPageSize
+	}
+	return pageSize
+}
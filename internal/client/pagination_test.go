@@ -0,0 +1,169 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+// pagedInts serves the integers [0, total) as pages and records each request.
+func pagedInts(total int, requests *[][2]int) PageFunc[int] {
+	return func(_ context.Context, limit, offset int) ([]int, int, error) {
+		*requests = append(*requests, [2]int{limit, offset})
+		var page []int
+		for i := offset; i < total && i < offset+limit; i++ {
+			page = append(page, i)
+		}
+		return page, total, nil
+	}
+}
+
+func TestPaginateWalksAllPages(t *testing.T) {
+	var requests [][2]int
+	items, err := Collect(Paginate(context.Background(), 10, pagedInts(25, &requests)))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(items) != 25 {
+		t.Fatalf("Expected 25 items, got %d", len(items))
+	}
+	for i, item := range items {
+		if item != i {
+			t.Errorf("Item %d: expected %d, got %d", i, i, item)
+		}
+	}
+
+	expected := [][2]int{{10, 0}, {10, 10}, {10, 20}}
+	if len(requests) != len(expected) {
+		t.Fatalf("Expected %d requests, got %d: %v", len(expected), len(requests), requests)
+	}
+	for i, r := range requests {
+		if r != expected[i] {
+			t.Errorf("Request %d: expected limit/offset %v, got %v", i, expected[i], r)
+		}
+	}
+}
+
+func TestPaginateStopsOnExactTotal(t *testing.T) {
+	var requests [][2]int
+	items, err := Collect(Paginate(context.Background(), 10, pagedInts(20, &requests)))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(items) != 20 {
+		t.Errorf("Expected 20 items, got %d", len(items))
+	}
+	if len(requests) != 2 {
+		t.Errorf("Expected 2 requests, got %d", len(requests))
+	}
+}
+
+func TestPaginateStopsOnShortPageWithoutTotal(t *testing.T) {
+	calls := 0
+	fetch := func(_ context.Context, limit, offset int) ([]int, int, error) {
+		calls++
+		if offset == 0 {
+			return make([]int, limit), 0, nil
+		}
+		return []int{1, 2}, 0, nil
+	}
+
+	items, err := Collect(Paginate(context.Background(), 5, fetch))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(items) != 7 {
+		t.Errorf("Expected 7 items, got %d", len(items))
+	}
+	if calls != 2 {
+		t.Errorf("Expected 2 requests, got %d", calls)
+	}
+}
+
+func TestPaginateEarlyTermination(t *testing.T) {
+	var requests [][2]int
+	var seen []int
+	for item, err := range Paginate(context.Background(), 10, pagedInts(1000, &requests)) {
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		seen = append(seen, item)
+		if item == 12 {
+			break
+		}
+	}
+
+	if len(seen) != 13 {
+		t.Errorf("Expected 13 items, got %d", len(seen))
+	}
+	if len(requests) != 2 {
+		t.Errorf("Expected only 2 pages to be fetched, got %d", len(requests))
+	}
+}
+
+func TestPaginateReturnsFetchError(t *testing.T) {
+	fetchErr := errors.New("boom")
+	fetch := func(_ context.Context, limit, offset int) ([]int, int, error) {
+		if offset > 0 {
+			return nil, 0, fetchErr
+		}
+		return make([]int, limit), 100, nil
+	}
+
+	items, err := Collect(Paginate(context.Background(), 10, fetch))
+	if !errors.Is(err, fetchErr) {
+		t.Errorf("Expected fetch error, got %v", err)
+	}
+	if items != nil {
+		t.Errorf("Expected no items on error, got %d", len(items))
+	}
+}
+
+func TestPaginateHonoursContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	calls := 0
+	fetch := func(_ context.Context, limit, offset int) ([]int, int, error) {
+		calls++
+		return nil, 0, nil
+	}
+
+	_, err := Collect(Paginate(ctx, 10, fetch))
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+	if calls != 0 {
+		t.Errorf("Expected no requests after cancellation, got %d", calls)
+	}
+}
+
+func TestPaginatePageSize(t *testing.T) {
+	tests := []struct {
+		name     string
+		pageSize int
+		expected int
+	}{
+		{"Default when zero", 0, DefaultPageSize},
+		{"Default when negative", -1, DefaultPageSize},
+		{"Custom", 25, 25},
+		{"Clamped to maximum", MaxPageSize + 1, MaxPageSize},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests [][2]int
+			_, _ = Collect(Paginate(context.Background(), tt.pageSize, pagedInts(0, &requests)))
+			if len(requests) != 1 || requests[0][0] != tt.expected {
+				t.Errorf("Expected limit %d, got %v", tt.expected, requests)
+			}
+		})
+	}
+}
+
+func TestPageQuery(t *testing.T) {
+	if got := PageQuery(50, 150).Encode(); got != "limit=50&offset=150" {
+		t.Errorf("Unexpected query: %s", got)
+	}
+}
@@ -0,0 +1,187 @@
+// Package client provides a client for interacting with the OpenProvider API.
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// DefaultMaxRetries -- number of retries after the initial attempt
+	DefaultMaxRetries = 3
+	// DefaultRetryBaseDelay -- delay before the first retry
+	DefaultRetryBaseDelay = 500 * time.Millisecond
+	// DefaultRetryMaxDelay -- upper bound for a single backoff delay
+	DefaultRetryMaxDelay = 30 * time.Second
+	// DefaultRetryJitter -- fraction of each delay that is randomized
+	DefaultRetryJitter = 0.2
+)
+
+// RetryPolicy configures how Do retries rate-limited and transient failures.
+// Do retries the responses retryableStatus accepts and honours Retry-After
+// headers, up to MaxDelay. Request bodies are rewound before every resend.
+type RetryPolicy struct {
+	// MaxRetries is the number of retries after the initial attempt. Zero disables retries.
+	MaxRetries int
+	// BaseDelay is the backoff before the first retry; it doubles on every further attempt.
+	BaseDelay time.Duration
+	// MaxDelay caps every delay, including delays requested through Retry-After.
+	MaxDelay time.Duration
+	// Jitter is the fraction (0-1) of each delay that is randomized to spread out retries.
+	Jitter float64
+}
+
+// DefaultRetryPolicy returns the retry policy used when none is configured.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries: DefaultMaxRetries,
+		BaseDelay:  DefaultRetryBaseDelay,
+		MaxDelay:   DefaultRetryMaxDelay,
+		Jitter:     DefaultRetryJitter,
+	}
+}
+
+// retryableStatus reports whether the response to req is worth retrying.
+// 500 is deliberately excluded: OpenProvider uses it for application errors
+// that will fail the same way on every attempt. Requests that are not idempotent
+// are only resent when the response shows that they were not processed: 429, or
+// 503 with Retry-After. A 502 or 504 may arrive after the server handled a POST,
+// and resending it could, for instance, register or renew a domain twice.
+func retryableStatus(req *http.Request, resp *http.Response) bool {
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusServiceUnavailable:
+		return idempotentMethod(req.Method) || resp.Header.Get("Retry-After") != ""
+	case http.StatusBadGateway, http.StatusGatewayTimeout:
+		return idempotentMethod(req.Method)
+	}
+	return false
+}
+
+// idempotentMethod reports whether a request can be resent when the server may
+// or may not have processed the first attempt, e.g. after a transport error.
+func idempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// shouldRetry decides whether the outcome of an attempt warrants another try.
+func (p RetryPolicy) shouldRetry(ctx context.Context, attempt int, req *http.Request, resp *http.Response, err error) bool {
+	if attempt >= p.MaxRetries || ctx.Err() != nil {
+		return false
+	}
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return false
+		}
+		return idempotentMethod(req.Method)
+	}
+	return retryableStatus(req, resp)
+}
+
+// backoff returns how long to wait before the given retry attempt (0-based).
+// A Retry-After header on resp takes precedence over the exponential schedule.
+func (p RetryPolicy) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+			return p.capDelay(wait)
+		}
+	}
+
+	delay := time.Duration(float64(p.BaseDelay) * math.Pow(2, float64(attempt)))
+	delay = p.capDelay(delay)
+
+	if p.Jitter > 0 && delay > 0 {
+		jitter := math.Min(p.Jitter, 1)
+		spread := float64(delay) * jitter
+		delay = time.Duration(float64(delay) - spread + rand.Float64()*spread)
+	}
+	return delay
+}
+
+// capDelay bounds d to the range [0, MaxDelay].
+func (p RetryPolicy) capDelay(d time.Duration) time.Duration {
+	if d < 0 {
+		return 0
+	}
+	if p.MaxDelay > 0 && d > p.MaxDelay {
+		return p.MaxDelay
+	}
+	return d
+}
+
+// parseRetryAfter parses a Retry-After header value given either as delay
+// seconds or as an HTTP date.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		return at.Sub(now), true
+	}
+	return 0, false
+}
+
+// sleep waits for d or until ctx is done, whichever comes first.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// makeRewindable makes sure req.GetBody is set so the body can be resent.
+// Requests built from a bytes.Buffer, bytes.Reader or strings.Reader already have
+// GetBody populated by net/http; any other body is buffered in memory once.
+func makeRewindable(req *http.Request) error {
+	if req.Body == nil || req.Body == http.NoBody || req.GetBody != nil {
+		return nil
+	}
+	body, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return err
+	}
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+	req.Body, _ = req.GetBody()
+	return nil
+}
+
+// rewind resets req.Body so the request can be sent again.
+func rewind(req *http.Request) error {
+	if req.GetBody == nil {
+		return nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return err
+	}
+	req.Body = body
+	return nil
+}
@@ -0,0 +1,252 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func fastRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxRetries: 3,
+		BaseDelay:  time.Millisecond,
+		MaxDelay:   10 * time.Millisecond,
+	}
+}
+
+func TestDoRetriesTransientFailures(t *testing.T) {
+	var calls atomic.Int32
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if calls.Add(1) < 3 {
+			// Retry-After shows that the POST was not processed
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"code": 0, "data": {}}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(Config{
+		BaseURL:    server.URL,
+		Token:      "valid-token",
+		Retry:      fastRetryPolicy(),
+		HTTPClient: server.Client(),
+	})
+
+	req, _ := http.NewRequest("POST", server.URL+"/v1beta/domains", strings.NewReader(`{"name":"example"}`))
+	resp, err := c.Do(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	_ = resp.Body.Close()
+
+	if calls.Load() != 3 {
+		t.Errorf("Expected 3 attempts, got %d", calls.Load())
+	}
+	for i, body := range bodies {
+		if body != `{"name":"example"}` {
+			t.Errorf("Attempt %d: expected body to be resent, got %q", i+1, body)
+		}
+	}
+}
+
+func TestDoGivesUpAfterMaxRetries(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusTooManyRequests)
+		_, _ = w.Write([]byte(`{"code": 429, "desc": "Too many requests"}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(Config{
+		Token:      "valid-token",
+		Retry:      fastRetryPolicy(),
+		HTTPClient: server.Client(),
+	})
+
+	req, _ := http.NewRequest("GET", server.URL+"/v1beta/dns/zones", nil)
+	_, err := c.Do(context.Background(), req)
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected *APIError, got %T: %v", err, err)
+	}
+	if apiErr.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("Expected status 429, got %d", apiErr.StatusCode)
+	}
+	if calls.Load() != 4 {
+		t.Errorf("Expected 4 attempts (1 + 3 retries), got %d", calls.Load())
+	}
+}
+
+func TestDoDoesNotRetryApplicationErrors(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	c := NewClient(Config{
+		Token:      "valid-token",
+		Retry:      fastRetryPolicy(),
+		HTTPClient: server.Client(),
+	})
+
+	req, _ := http.NewRequest("GET", server.URL+"/v1beta/domains", nil)
+	if _, err := c.Do(context.Background(), req); err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+	if calls.Load() != 1 {
+		t.Errorf("Expected a single attempt, got %d", calls.Load())
+	}
+}
+
+func TestDoDoesNotResendProcessedPosts(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		status     int
+		retryAfter string
+		wantCalls  int32
+	}{
+		{"POST after 502", "POST", http.StatusBadGateway, "", 1},
+		{"POST after 504", "POST", http.StatusGatewayTimeout, "", 1},
+		{"POST after 503", "POST", http.StatusServiceUnavailable, "", 1},
+		{"POST after 503 with Retry-After", "POST", http.StatusServiceUnavailable, "0", 4},
+		{"POST after 429", "POST", http.StatusTooManyRequests, "", 4},
+		{"PUT after 504", "PUT", http.StatusGatewayTimeout, "", 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				calls.Add(1)
+				if tt.retryAfter != "" {
+					w.Header().Set("Retry-After", tt.retryAfter)
+				}
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			c := NewClient(Config{
+				Token:      "valid-token",
+				Retry:      fastRetryPolicy(),
+				HTTPClient: server.Client(),
+			})
+
+			req, _ := http.NewRequest(tt.method, server.URL+"/v1beta/domains/42/renew", strings.NewReader(`{"period":1}`))
+			if _, err := c.Do(context.Background(), req); err == nil {
+				t.Fatal("Expected error, got nil")
+			}
+			if calls.Load() != tt.wantCalls {
+				t.Errorf("Expected %d attempts, got %d", tt.wantCalls, calls.Load())
+			}
+		})
+	}
+}
+
+func TestDoStopsRetryingWhenContextIsCancelled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	c := NewClient(Config{
+		Token: "valid-token",
+		Retry: &RetryPolicy{
+			MaxRetries: 5,
+			BaseDelay:  time.Minute,
+			MaxDelay:   time.Minute,
+		},
+		HTTPClient: server.Client(),
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	req, _ := http.NewRequest("GET", server.URL+"/v1beta/domains", nil)
+	_, err := c.Do(ctx, req)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	p := RetryPolicy{
+		BaseDelay: 100 * time.Millisecond,
+		MaxDelay:  time.Second,
+	}
+
+	expected := []time.Duration{
+		100 * time.Millisecond,
+		200 * time.Millisecond,
+		400 * time.Millisecond,
+		800 * time.Millisecond,
+		time.Second,
+	}
+	for attempt, want := range expected {
+		if got := p.backoff(attempt, nil); got != want {
+			t.Errorf("attempt %d: expected %s, got %s", attempt, want, got)
+		}
+	}
+
+	p.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		got := p.backoff(2, nil)
+		if got < 200*time.Millisecond || got > 400*time.Millisecond {
+			t.Fatalf("Expected jittered delay within [200ms, 400ms], got %s", got)
+		}
+	}
+
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"120"}}}
+	if got := p.backoff(0, resp); got != time.Second {
+		t.Errorf("Expected Retry-After to be capped at MaxDelay, got %s", got)
+	}
+	resp.Header.Set("Retry-After", "0")
+	if got := p.backoff(3, resp); got != 0 {
+		t.Errorf("Expected Retry-After 0 to be honoured, got %s", got)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name   string
+		value  string
+		want   time.Duration
+		wantOK bool
+	}{
+		{name: "empty", value: "", wantOK: false},
+		{name: "seconds", value: "7", want: 7 * time.Second, wantOK: true},
+		{name: "negative", value: "-1", wantOK: false},
+		{name: "http date", value: now.Add(30 * time.Second).Format(http.TimeFormat), want: 30 * time.Second, wantOK: true},
+		{name: "garbage", value: "soon", wantOK: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := parseRetryAfter(tc.value, now)
+			if ok != tc.wantOK {
+				t.Fatalf("Expected ok=%v, got %v", tc.wantOK, ok)
+			}
+			if ok && got != tc.want {
+				t.Errorf("Expected %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
@@ -3,52 +3,68 @@ package ssl
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 
 	"github.com/charpand/terraform-provider-openprovider/internal/client"
 )
 
-// ListOrders lists all SSL orders.
+// ListOrders lists all SSL orders, following pagination.
 //
 // Endpoint: GET https://api.openprovider.eu/v1beta/ssl/orders
-func ListOrders(c *client.Client) ([]SSLOrder, error) {
+func ListOrders(ctx context.Context, c *client.Client) ([]SSLOrder, error) {
+	return client.Collect(ListOrdersIter(ctx, c))
+}
+
+// ListOrdersIter returns an iterator over all SSL orders, fetching pages of c.PageSize lazily.
+// Breaking out of the loop stops fetching further pages.
+func ListOrdersIter(ctx context.Context, c *client.Client) iter.Seq2[SSLOrder, error] {
+	return client.Paginate(ctx, c.PageSize, func(ctx context.Context, limit, offset int) ([]SSLOrder, int, error) {
+		return listOrdersPage(ctx, c, limit, offset)
+	})
+}
+
+// listOrdersPage retrieves a single page of SSL orders.
+func listOrdersPage(ctx context.Context, c *client.Client, limit, offset int) ([]SSLOrder, int, error) {
 	path := "/v1beta/ssl/orders"
-	httpReq, err := http.NewRequest("GET", fmt.Sprintf("%s%s", c.BaseURL, path), nil)
+	query := client.PageQuery(limit, offset)
+	httpReq, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s%s?%s", c.BaseURL, path, query.Encode()), nil)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
-	resp, err := c.Do(httpReq)
+	resp, err := c.Do(ctx, httpReq)
 	if resp != nil {
 		defer func() {
 			_ = resp.Body.Close()
 		}()
 	}
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	var result ListSSLOrdersResponse
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
-	return result.Data.Results, nil
+	return result.Data.Results, result.Data.Total, nil
 }
 
 // GetOrder retrieves a specific SSL order by ID.
 //
 // Endpoint: GET https://api.openprovider.eu/v1beta/ssl/orders/{id}
-func GetOrder(c *client.Client, orderID int) (*SSLOrder, error) {
+func GetOrder(ctx context.Context, c *client.Client, orderID int) (*SSLOrder, error) {
 	path := fmt.Sprintf("/v1beta/ssl/orders/%d", orderID)
-	httpReq, err := http.NewRequest("GET", fmt.Sprintf("%s%s", c.BaseURL, path), nil)
+	httpReq, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s%s", c.BaseURL, path), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := c.Do(httpReq)
+	resp, err := c.Do(ctx, httpReq)
 	if resp != nil {
 		defer func() {
 			_ = resp.Body.Close()
@@ -69,20 +85,20 @@ func GetOrder(c *client.Client, orderID int) (*SSLOrder, error) {
 // CreateOrder creates a new SSL order.
 //
 // Endpoint: POST https://api.openprovider.eu/v1beta/ssl/orders
-func CreateOrder(c *client.Client, req *CreateSSLOrderRequest) (*SSLOrder, error) {
+func CreateOrder(ctx context.Context, c *client.Client, req *CreateSSLOrderRequest) (*SSLOrder, error) {
 	body, err := json.Marshal(req)
 	if err != nil {
 		return nil, err
 	}
 
 	path := "/v1beta/ssl/orders"
-	httpReq, err := http.NewRequest("POST", fmt.Sprintf("%s%s", c.BaseURL, path), bytes.NewBuffer(body))
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s%s", c.BaseURL, path), bytes.NewBuffer(body))
 	if err != nil {
 		return nil, err
 	}
 
 	httpReq.Header.Set("Content-Type", "application/json")
-	resp, err := c.Do(httpReq)
+	resp, err := c.Do(ctx, httpReq)
 	if resp != nil {
 		defer func() {
 			_ = resp.Body.Close()
@@ -103,20 +119,20 @@ func CreateOrder(c *client.Client, req *CreateSSLOrderRequest) (*SSLOrder, error
 // UpdateOrder updates an existing SSL order (e.g., autorenew settings).
 //
 // Endpoint: PATCH https://api.openprovider.eu/v1beta/ssl/orders/{id}
-func UpdateOrder(c *client.Client, orderID int, req *UpdateSSLOrderRequest) (*SSLOrder, error) {
+func UpdateOrder(ctx context.Context, c *client.Client, orderID int, req *UpdateSSLOrderRequest) (*SSLOrder, error) {
 	body, err := json.Marshal(req)
 	if err != nil {
 		return nil, err
 	}
 
 	path := fmt.Sprintf("/v1beta/ssl/orders/%d", orderID)
-	httpReq, err := http.NewRequest("PATCH", fmt.Sprintf("%s%s", c.BaseURL, path), bytes.NewBuffer(body))
+	httpReq, err := http.NewRequestWithContext(ctx, "PATCH", fmt.Sprintf("%s%s", c.BaseURL, path), bytes.NewBuffer(body))
 	if err != nil {
 		return nil, err
 	}
 
 	httpReq.Header.Set("Content-Type", "application/json")
-	resp, err := c.Do(httpReq)
+	resp, err := c.Do(ctx, httpReq)
 	if resp != nil {
 		defer func() {
 			_ = resp.Body.Close()
@@ -137,20 +153,20 @@ func UpdateOrder(c *client.Client, orderID int, req *UpdateSSLOrderRequest) (*SS
 // RenewOrder renews an existing SSL order.
 //
 // Endpoint: POST https://api.openprovider.eu/v1beta/ssl/orders/{id}/renew
-func RenewOrder(c *client.Client, orderID int, req *RenewSSLOrderRequest) (*SSLOrder, error) {
+func RenewOrder(ctx context.Context, c *client.Client, orderID int, req *RenewSSLOrderRequest) (*SSLOrder, error) {
 	body, err := json.Marshal(req)
 	if err != nil {
 		return nil, err
 	}
 
 	path := fmt.Sprintf("/v1beta/ssl/orders/%d/renew", orderID)
-	httpReq, err := http.NewRequest("POST", fmt.Sprintf("%s%s", c.BaseURL, path), bytes.NewBuffer(body))
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s%s", c.BaseURL, path), bytes.NewBuffer(body))
 	if err != nil {
 		return nil, err
 	}
 
 	httpReq.Header.Set("Content-Type", "application/json")
-	resp, err := c.Do(httpReq)
+	resp, err := c.Do(ctx, httpReq)
 	if resp != nil {
 		defer func() {
 			_ = resp.Body.Close()
@@ -171,20 +187,20 @@ func RenewOrder(c *client.Client, orderID int, req *RenewSSLOrderRequest) (*SSLO
 // ReissueOrder reissues an existing SSL order.
 //
 // Endpoint: POST https://api.openprovider.eu/v1beta/ssl/orders/{id}/reissue
-func ReissueOrder(c *client.Client, orderID int, req *ReissueSSLOrderRequest) (*SSLOrder, error) {
+func ReissueOrder(ctx context.Context, c *client.Client, orderID int, req *ReissueSSLOrderRequest) (*SSLOrder, error) {
 	body, err := json.Marshal(req)
 	if err != nil {
 		return nil, err
 	}
 
 	path := fmt.Sprintf("/v1beta/ssl/orders/%d/reissue", orderID)
-	httpReq, err := http.NewRequest("POST", fmt.Sprintf("%s%s", c.BaseURL, path), bytes.NewBuffer(body))
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s%s", c.BaseURL, path), bytes.NewBuffer(body))
 	if err != nil {
 		return nil, err
 	}
 
 	httpReq.Header.Set("Content-Type", "application/json")
-	resp, err := c.Do(httpReq)
+	resp, err := c.Do(ctx, httpReq)
 	if resp != nil {
 		defer func() {
 			_ = resp.Body.Close()
@@ -205,14 +221,14 @@ func ReissueOrder(c *client.Client, orderID int, req *ReissueSSLOrderRequest) (*
 // CancelOrder cancels an SSL order.
 //
 // Endpoint: DELETE https://api.openprovider.eu/v1beta/ssl/orders/{id}
-func CancelOrder(c *client.Client, orderID int) error {
+func CancelOrder(ctx context.Context, c *client.Client, orderID int) error {
 	path := fmt.Sprintf("/v1beta/ssl/orders/%d", orderID)
-	httpReq, err := http.NewRequest("DELETE", fmt.Sprintf("%s%s", c.BaseURL, path), nil)
+	httpReq, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s%s", c.BaseURL, path), nil)
 	if err != nil {
 		return err
 	}
 
-	resp, err := c.Do(httpReq)
+	resp, err := c.Do(ctx, httpReq)
 	if resp != nil {
 		defer func() {
 			_ = resp.Body.Close()
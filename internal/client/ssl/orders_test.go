@@ -2,6 +2,7 @@
 package ssl
 
 import (
+	"context"
 	"net/http"
 	"os"
 	"testing"
@@ -28,7 +29,7 @@ func TestListOrders(t *testing.T) {
 	}
 	c := client.NewClient(config)
 
-	orders, err := ListOrders(c)
+	orders, err := ListOrders(context.Background(), c)
 	if err != nil {
 		t.Logf("Note: API returned error (expected if mock server not running): %v", err)
 		return
@@ -60,7 +61,7 @@ func TestGetOrder(t *testing.T) {
 	}
 	c := client.NewClient(config)
 
-	order, err := GetOrder(c, 123)
+	order, err := GetOrder(context.Background(), c, 123)
 	if err != nil {
 		t.Logf("Note: API returned error (expected if mock server not running): %v", err)
 		return
@@ -97,7 +98,7 @@ func TestCreateOrder(t *testing.T) {
 		CommonName: "example.com",
 	}
 
-	order, err := CreateOrder(c, req)
+	order, err := CreateOrder(context.Background(), c, req)
 	if err != nil {
 		t.Logf("Note: API returned error (expected if mock server not running): %v", err)
 		return
@@ -133,7 +134,7 @@ func TestUpdateOrder(t *testing.T) {
 		Autorenew: "on",
 	}
 
-	order, err := UpdateOrder(c, 123, req)
+	order, err := UpdateOrder(context.Background(), c, 123, req)
 	if err != nil {
 		t.Logf("Note: API returned error (expected if mock server not running): %v", err)
 		return
@@ -169,7 +170,7 @@ func TestRenewOrder(t *testing.T) {
 		Period: 1,
 	}
 
-	order, err := RenewOrder(c, 123, req)
+	order, err := RenewOrder(context.Background(), c, 123, req)
 	if err != nil {
 		t.Logf("Note: API returned error (expected if mock server not running): %v", err)
 		return
@@ -205,7 +206,7 @@ func TestReissueOrder(t *testing.T) {
 		CommonName: "example.com",
 	}
 
-	order, err := ReissueOrder(c, 123, req)
+	order, err := ReissueOrder(context.Background(), c, 123, req)
 	if err != nil {
 		t.Logf("Note: API returned error (expected if mock server not running): %v", err)
 		return
@@ -237,7 +238,7 @@ func TestCancelOrder(t *testing.T) {
 	}
 	c := client.NewClient(config)
 
-	err := CancelOrder(c, 123)
+	err := CancelOrder(context.Background(), c, 123)
 	if err != nil {
 		t.Logf("Note: API returned error (expected if mock server not running): %v", err)
 		return
@@ -2,52 +2,68 @@
 package ssl
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 
 	"github.com/charpand/terraform-provider-openprovider/internal/client"
 )
 
-// ListProducts lists all available SSL products.
+// ListProducts lists all available SSL products, following pagination.
 //
 // Endpoint: GET https://api.openprovider.eu/v1beta/ssl/products
-func ListProducts(c *client.Client) ([]SSLProduct, error) {
+func ListProducts(ctx context.Context, c *client.Client) ([]SSLProduct, error) {
+	return client.Collect(ListProductsIter(ctx, c))
+}
+
+// ListProductsIter returns an iterator over all SSL products, fetching pages of c.PageSize lazily.
+// Breaking out of the loop stops fetching further pages.
+func ListProductsIter(ctx context.Context, c *client.Client) iter.Seq2[SSLProduct, error] {
+	return client.Paginate(ctx, c.PageSize, func(ctx context.Context, limit, offset int) ([]SSLProduct, int, error) {
+		return listProductsPage(ctx, c, limit, offset)
+	})
+}
+
+// listProductsPage retrieves a single page of SSL products.
+func listProductsPage(ctx context.Context, c *client.Client, limit, offset int) ([]SSLProduct, int, error) {
 	path := "/v1beta/ssl/products"
-	httpReq, err := http.NewRequest("GET", fmt.Sprintf("%s%s", c.BaseURL, path), nil)
+	query := client.PageQuery(limit, offset)
+	httpReq, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s%s?%s", c.BaseURL, path, query.Encode()), nil)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
-	resp, err := c.Do(httpReq)
+	resp, err := c.Do(ctx, httpReq)
 	if resp != nil {
 		defer func() {
 			_ = resp.Body.Close()
 		}()
 	}
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	var result ListSSLProductsResponse
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
-	return result.Data.Results, nil
+	return result.Data.Results, result.Data.Total, nil
 }
 
 // GetProduct retrieves a specific SSL product by ID.
 //
 // Endpoint: GET https://api.openprovider.eu/v1beta/ssl/products/{id}
-func GetProduct(c *client.Client, productID int) (*SSLProduct, error) {
+func GetProduct(ctx context.Context, c *client.Client, productID int) (*SSLProduct, error) {
 	path := fmt.Sprintf("/v1beta/ssl/products/%d", productID)
-	httpReq, err := http.NewRequest("GET", fmt.Sprintf("%s%s", c.BaseURL, path), nil)
+	httpReq, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s%s", c.BaseURL, path), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := c.Do(httpReq)
+	resp, err := c.Do(ctx, httpReq)
 	if resp != nil {
 		defer func() {
 			_ = resp.Body.Close()
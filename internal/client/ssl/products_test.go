@@ -2,6 +2,7 @@
 package ssl
 
 import (
+	"context"
 	"net/http"
 	"os"
 	"testing"
@@ -28,7 +29,7 @@ func TestListProducts(t *testing.T) {
 	}
 	c := client.NewClient(config)
 
-	products, err := ListProducts(c)
+	products, err := ListProducts(context.Background(), c)
 	if err != nil {
 		t.Logf("Note: API returned error (expected if mock server not running): %v", err)
 		return
@@ -60,7 +61,7 @@ func TestGetProduct(t *testing.T) {
 	}
 	c := client.NewClient(config)
 
-	product, err := GetProduct(c, 1)
+	product, err := GetProduct(context.Background(), c, 1)
 	if err != nil {
 		t.Logf("Note: API returned error (expected if mock server not running): %v", err)
 		return
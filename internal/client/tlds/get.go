@@ -0,0 +1,57 @@
+// Package tlds provides functionality for working with the extensions (top-level
+// domains) that OpenProvider sells.
+package tlds
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client"
+)
+
+// GetTLDResponse represents a response for a single extension.
+type GetTLDResponse struct {
+	Code int `json:"code"`
+	Data TLD `json:"data"`
+}
+
+// Get retrieves a single extension, such as "com" or ".co.uk". With withPrice set,
+// the result carries the extension's prices.
+//
+// Endpoint: GET https://api.openprovider.eu/v1beta/tlds/{name}
+func Get(ctx context.Context, c *client.Client, name string, withPrice bool) (*TLD, error) {
+	name, err := NormalizeName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	path := "/v1beta/tlds/" + url.PathEscape(name)
+	query := url.Values{}
+	if withPrice {
+		query.Set("with_price", "true")
+	}
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s%s?%s", c.BaseURL, path, query.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.Do(ctx, req)
+	if resp != nil {
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var result GetTLDResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return &result.Data, nil
+}
@@ -0,0 +1,62 @@
+// Package tlds_test contains tests for the tlds package.
+package tlds_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client"
+	"github.com/charpand/terraform-provider-openprovider/internal/client/tlds"
+)
+
+func TestGetTLD(t *testing.T) {
+	var path, query string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path, query = r.URL.Path, r.URL.RawQuery
+		_, _ = w.Write([]byte(`{"code": 0, "data": {"name": "us", "status": "ACT", "type": "ccTLD",
+			"min_period": 1, "max_period": 10, "required_additional_data": ["nexus_category", "application_purpose"],
+			"prices": {"renew_price": {"product": {"currency": "USD", "price": 8}, "reseller": {"currency": "EUR", "price": 7.5}}}}}`))
+	}))
+	defer server.Close()
+
+	apiClient := client.NewClient(client.Config{
+		BaseURL:    server.URL,
+		Token:      "test-token",
+		HTTPClient: server.Client(),
+	})
+
+	tld, err := tlds.Get(context.Background(), apiClient, ".US", true)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if path != "/v1beta/tlds/us" || query != "with_price=true" {
+		t.Errorf("Unexpected request: %s?%s", path, query)
+	}
+	if tld.Name != "us" || len(tld.RequiredAdditionalData) != 2 || tld.RequiredAdditionalData[0] != "nexus_category" {
+		t.Errorf("Unexpected extension: %+v", tld)
+	}
+	if tld.Prices == nil || tld.Prices.RenewPrice.Reseller.Price != 7.5 {
+		t.Errorf("Expected a renewal price of 7.5, got %+v", tld.Prices)
+	}
+}
+
+func TestGetTLDNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"code": 404, "desc": "TLD not found"}`))
+	}))
+	defer server.Close()
+
+	apiClient := client.NewClient(client.Config{
+		BaseURL:    server.URL,
+		Token:      "test-token",
+		HTTPClient: server.Client(),
+	})
+
+	_, err := tlds.Get(context.Background(), apiClient, "invalid", false)
+	if !client.IsNotFound(err) {
+		t.Errorf("Expected a not found error, got %v", err)
+	}
+}
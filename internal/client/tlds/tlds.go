@@ -0,0 +1,166 @@
+// Package tlds provides functionality for working with the extensions (top-level
+// domains) that OpenProvider sells.
+package tlds
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client"
+	"github.com/charpand/terraform-provider-openprovider/internal/client/domains"
+	"github.com/charpand/terraform-provider-openprovider/internal/domainname"
+)
+
+// StatusActive is the status of extensions that can be ordered.
+const StatusActive = "ACT"
+
+// Price is the price of an operation on domains under an extension.
+type Price struct {
+	// Product is the price in the registry's currency.
+	Product domains.Amount `json:"product"`
+	// Reseller is the price charged to the reseller account, in its currency.
+	Reseller domains.Amount `json:"reseller"`
+}
+
+// Prices holds the one-year prices of the operations on domains under an extension.
+type Prices struct {
+	CreatePrice   Price `json:"create_price"`
+	RenewPrice    Price `json:"renew_price"`
+	TransferPrice Price `json:"transfer_price"`
+	RestorePrice  Price `json:"restore_price"`
+}
+
+// TLD represents an extension and the rules of its registry.
+type TLD struct {
+	// Name is the extension without a leading dot in ASCII form, e.g. "com" or "co.uk".
+	Name string `json:"name"`
+	// Status is StatusActive for extensions that can be ordered.
+	Status string `json:"status"`
+	// Type is the kind of extension, e.g. "gTLD" or "ccTLD".
+	Type        string `json:"type"`
+	Description string `json:"description,omitempty"`
+	// MinPeriod and MaxPeriod bound the registration period in years.
+	MinPeriod             int  `json:"min_period"`
+	MaxPeriod             int  `json:"max_period"`
+	IsDnssecAllowed       bool `json:"dnssec_allowed"`
+	IsPrivateWhoisAllowed bool `json:"is_private_whois_allowed"`
+	// IdnScripts lists the scripts allowed in internationalized domain names.
+	// It is empty for extensions without IDN support.
+	IdnScripts []string `json:"idn_scripts,omitempty"`
+	// MinNameservers and MaxNameservers bound the number of nameservers of a
+	// domain. They are zero when the registry sets no bound.
+	MinNameservers             int  `json:"min_nameservers,omitempty"`
+	MaxNameservers             int  `json:"max_nameservers,omitempty"`
+	IsTransferAuthCodeRequired bool `json:"is_transfer_auth_code_required"`
+	// RequiredAdditionalData names the additional data fields a registration
+	// needs, e.g. "nexus_category" for us.
+	RequiredAdditionalData []string `json:"required_additional_data,omitempty"`
+	// Prices is only set when prices were requested.
+	Prices *Prices `json:"prices,omitempty"`
+}
+
+// Periods returns the registration periods in years that the extension supports.
+func (t *TLD) Periods() []int {
+	var periods []int
+	for period := t.MinPeriod; period > 0 && period <= t.MaxPeriod; period++ {
+		periods = append(periods, period)
+	}
+	return periods
+}
+
+// IsIDNAllowed reports whether internationalized domain names can be registered
+// under the extension.
+func (t *TLD) IsIDNAllowed() bool {
+	return len(t.IdnScripts) > 0
+}
+
+// ListTLDsResponse represents a response for listing extensions.
+type ListTLDsResponse struct {
+	Code int `json:"code"`
+	Data struct {
+		Results []TLD `json:"results"`
+		Total   int   `json:"total"`
+	} `json:"data"`
+}
+
+// ListOptions filters the results of List and ListIter.
+// Zero-valued fields are not sent to the API.
+type ListOptions struct {
+	// NamePattern matches the extension; "*" acts as a wildcard, e.g. "co.*".
+	NamePattern string
+	// Status restricts results to extensions in the given status, e.g. StatusActive.
+	Status string
+	// WithPrice includes the prices of each extension.
+	WithPrice bool
+}
+
+// apply adds the query parameters for the options to query.
+func (o *ListOptions) apply(query url.Values) {
+	if o == nil {
+		return
+	}
+	if o.NamePattern != "" {
+		query.Set("name_pattern", o.NamePattern)
+	}
+	if o.Status != "" {
+		query.Set("status", o.Status)
+	}
+	if o.WithPrice {
+		query.Set("with_price", "true")
+	}
+}
+
+// List retrieves all extensions matching opts from the Openprovider API, following
+// pagination. A nil opts lists every extension.
+//
+// Endpoint: GET https://api.openprovider.eu/v1beta/tlds
+func List(ctx context.Context, c *client.Client, opts *ListOptions) ([]TLD, error) {
+	return client.Collect(ListIter(ctx, c, opts))
+}
+
+// ListIter returns an iterator over all extensions matching opts, fetching pages of
+// c.PageSize lazily. Breaking out of the loop stops fetching further pages.
+func ListIter(ctx context.Context, c *client.Client, opts *ListOptions) iter.Seq2[TLD, error] {
+	return client.Paginate(ctx, c.PageSize, func(ctx context.Context, limit, offset int) ([]TLD, int, error) {
+		return listPage(ctx, c, opts, limit, offset)
+	})
+}
+
+// listPage retrieves a single page of extensions.
+func listPage(ctx context.Context, c *client.Client, opts *ListOptions, limit, offset int) ([]TLD, int, error) {
+	path := "/v1beta/tlds"
+	query := client.PageQuery(limit, offset)
+	opts.apply(query)
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s%s?%s", c.BaseURL, path, query.Encode()), nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	resp, err := c.Do(ctx, req)
+	if resp != nil {
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var result ListTLDsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, 0, err
+	}
+
+	return result.Data.Results, result.Data.Total, nil
+}
+
+// NormalizeName returns an extension such as ".CO.UK" or "рф" in the form the
+// API uses, e.g. "co.uk" or "xn--p1ai".
+func NormalizeName(name string) (string, error) {
+	return domainname.ToASCII(strings.TrimPrefix(strings.TrimSpace(name), "."))
+}
@@ -0,0 +1,126 @@
+// Package tlds_test contains tests for the tlds package.
+package tlds_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client"
+	"github.com/charpand/terraform-provider-openprovider/internal/client/tlds"
+)
+
+func TestListTLDs(t *testing.T) {
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1beta/tlds" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		queries = append(queries, r.URL.RawQuery)
+		if r.URL.Query().Get("offset") == "0" {
+			_, _ = w.Write([]byte(`{"code": 0, "data": {"total": 2, "results": [
+				{"name": "com", "status": "ACT", "type": "gTLD", "min_period": 1, "max_period": 10,
+				 "dnssec_allowed": true, "is_private_whois_allowed": true, "idn_scripts": ["de", "es"],
+				 "is_transfer_auth_code_required": true,
+				 "prices": {"create_price": {"product": {"currency": "USD", "price": 10.5}, "reseller": {"currency": "EUR", "price": 9.75}}}}
+			]}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"code": 0, "data": {"total": 2, "results": [
+			{"name": "co.uk", "status": "ACT", "type": "ccTLD", "min_period": 1, "max_period": 2,
+			 "min_nameservers": 2, "max_nameservers": 10}
+		]}}`))
+	}))
+	defer server.Close()
+
+	apiClient := client.NewClient(client.Config{
+		BaseURL:    server.URL,
+		Token:      "test-token",
+		HTTPClient: server.Client(),
+		PageSize:   1,
+	})
+
+	results, err := tlds.List(context.Background(), apiClient, &tlds.ListOptions{
+		NamePattern: "c*",
+		Status:      tlds.StatusActive,
+		WithPrice:   true,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(queries) != 2 {
+		t.Fatalf("Expected 2 page requests, got %d", len(queries))
+	}
+	if want := "limit=1&name_pattern=c%2A&offset=0&status=ACT&with_price=true"; queries[0] != want {
+		t.Errorf("Expected query %s, got %s", want, queries[0])
+	}
+
+	if len(results) != 2 || results[0].Name != "com" || results[1].Name != "co.uk" {
+		t.Fatalf("Unexpected results: %+v", results)
+	}
+
+	com := results[0]
+	if !com.IsDnssecAllowed || !com.IsPrivateWhoisAllowed || !com.IsIDNAllowed() || !com.IsTransferAuthCodeRequired {
+		t.Errorf("Unexpected flags for com: %+v", com)
+	}
+	if com.Prices == nil || com.Prices.CreatePrice.Reseller.Price != 9.75 {
+		t.Errorf("Expected a reseller price of 9.75, got %+v", com.Prices)
+	}
+	if periods := com.Periods(); len(periods) != 10 || periods[0] != 1 || periods[9] != 10 {
+		t.Errorf("Expected periods 1 to 10, got %v", periods)
+	}
+
+	coUK := results[1]
+	if coUK.IsIDNAllowed() || coUK.Prices != nil || coUK.MinNameservers != 2 || coUK.MaxNameservers != 10 {
+		t.Errorf("Unexpected co.uk: %+v", coUK)
+	}
+}
+
+func TestTLDPeriods(t *testing.T) {
+	tests := []struct {
+		name string
+		tld  tlds.TLD
+		want []int
+	}{
+		{"Range", tlds.TLD{MinPeriod: 2, MaxPeriod: 4}, []int{2, 3, 4}},
+		{"Single", tlds.TLD{MinPeriod: 1, MaxPeriod: 1}, []int{1}},
+		{"Unknown", tlds.TLD{}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.tld.Periods(); !slices.Equal(got, tt.want) {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestNormalizeName(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    string
+		wantErr bool
+	}{
+		{"com", "com", false},
+		{".CO.UK", "co.uk", false},
+		{"рф", "xn--p1ai", false},
+		{"", "", true},
+		{"co..uk", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tlds.NormalizeName(tt.name)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Expected error %v, got %v", tt.wantErr, err)
+			}
+			if got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
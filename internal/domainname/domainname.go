@@ -0,0 +1,156 @@
+// Package domainname splits domain names into the name and extension pair used
+// by the OpenProvider API.
+//
+// Extensions are found with the ICANN section of the public suffix list, so that
+// multi-label extensions such as co.uk are kept whole, and with a catalogue of
+// OpenProvider extensions the list does not cover, such as uk.com.
+// Internationalized domain names are converted to their ASCII (A-label) form.
+package domainname
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/net/idna"
+	"golang.org/x/net/publicsuffix"
+)
+
+//go:embed catalogue.txt
+var catalogueData string
+
+// catalogue holds the extensions listed in catalogue.txt.
+var catalogue = parseCatalogue(catalogueData)
+
+// ErrInvalidDomainName is wrapped by every error returned by Parse.
+var ErrInvalidDomainName = errors.New("invalid domain name")
+
+// Name is a registrable domain name split into its parts. Both parts are in
+// lower case ASCII (A-label) form.
+type Name struct {
+	// Label is the name without its extension, e.g. "example" or "xn--mnchen-3ya".
+	Label string
+	// Extension is the extension without a leading dot, e.g. "com" or "co.uk".
+	Extension string
+}
+
+// Parse splits a domain name such as example.co.uk or münchen.de into its label
+// and extension. The name may be given in Unicode or ASCII form, in any case and
+// with a trailing dot. Subdomains and bare extensions are rejected.
+func Parse(domain string) (Name, error) {
+	ascii, err := ToASCII(domain)
+	if err != nil {
+		return Name{}, err
+	}
+	if !strings.Contains(ascii, ".") {
+		return Name{}, fmt.Errorf("%w %q: expected a name and an extension such as example.com", ErrInvalidDomainName, domain)
+	}
+
+	extension := Extension(ascii)
+	if extension == ascii {
+		return Name{}, fmt.Errorf("%w %q: %s is an extension, not a domain", ErrInvalidDomainName, domain, extension)
+	}
+
+	label := strings.TrimSuffix(ascii, "."+extension)
+	if strings.Contains(label, ".") {
+		parent := label[strings.LastIndex(label, ".")+1:] + "." + extension
+		return Name{}, fmt.Errorf("%w %q: it is a subdomain of %s", ErrInvalidDomainName, domain, parent)
+	}
+
+	return Name{Label: label, Extension: extension}, nil
+}
+
+// String returns the domain name in ASCII form, e.g. "xn--mnchen-3ya.de".
+func (n Name) String() string {
+	return n.Label + "." + n.Extension
+}
+
+// Unicode returns the domain name in Unicode form, e.g. "münchen.de".
+func (n Name) Unicode() string {
+	return ToUnicode(n.String())
+}
+
+// Extension returns the extension of an ASCII domain name, e.g. "co.uk" for
+// example.co.uk. Names under an unknown top-level domain get that domain as
+// their extension.
+func Extension(ascii string) string {
+	// The catalogue takes precedence, longest match first
+	for rest := ascii; ; {
+		if catalogue[rest] {
+			return rest
+		}
+		_, parent, ok := strings.Cut(rest, ".")
+		if !ok {
+			break
+		}
+		rest = parent
+	}
+
+	// Private public suffixes, such as github.io, are hosting services rather than
+	// extensions, so the lookup walks up to the closest ICANN suffix.
+	suffix, icann := publicsuffix.PublicSuffix(ascii)
+	for !icann {
+		_, parent, ok := strings.Cut(suffix, ".")
+		if !ok {
+			break
+		}
+		suffix, icann = publicsuffix.PublicSuffix(parent)
+	}
+
+	return suffix
+}
+
+// ToASCII converts a domain name to lower case ASCII (A-label) form, removing a
+// trailing dot.
+func ToASCII(domain string) (string, error) {
+	trimmed := strings.TrimSuffix(strings.TrimSpace(domain), ".")
+	if trimmed == "" {
+		return "", fmt.Errorf("%w %q: name is empty", ErrInvalidDomainName, domain)
+	}
+
+	if !utf8.ValidString(trimmed) {
+		return "", fmt.Errorf("%w %q: name is not valid UTF-8", ErrInvalidDomainName, domain)
+	}
+
+	ascii, err := idna.Lookup.ToASCII(trimmed)
+	if err == nil {
+		// Reject A-labels that do not decode, such as xn--0-p10i
+		_, err = idna.Lookup.ToUnicode(ascii)
+	}
+	if err != nil {
+		return "", fmt.Errorf("%w %q: %s", ErrInvalidDomainName, domain, err.Error())
+	}
+
+	for _, label := range strings.Split(ascii, ".") {
+		if label == "" {
+			return "", fmt.Errorf("%w %q: empty label", ErrInvalidDomainName, domain)
+		}
+	}
+
+	return ascii, nil
+}
+
+// ToUnicode converts a domain name to Unicode form. Labels that cannot be
+// converted are returned unchanged.
+func ToUnicode(domain string) string {
+	unicode, err := idna.Lookup.ToUnicode(domain)
+	if err != nil {
+		return domain
+	}
+	return unicode
+}
+
+// parseCatalogue reads one extension per line, skipping blank lines and comments.
+func parseCatalogue(data string) map[string]bool {
+	extensions := make(map[string]bool)
+	for _, line := range strings.Split(data, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		extensions[line] = true
+	}
+	return extensions
+}
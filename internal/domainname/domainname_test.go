@@ -0,0 +1,121 @@
+// Package domainname_test contains tests for the domainname package.
+package domainname_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/domainname"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		domain    string
+		label     string
+		extension string
+	}{
+		{"example.com", "example", "com"},
+		{"Example.COM.", "example", "com"},
+		{" example.com ", "example", "com"},
+		{"example.co.uk", "example", "co.uk"},
+		{"example.com.au", "example", "com.au"},
+		{"example.co.jp", "example", "co.jp"},
+		{"example.nl", "example", "nl"},
+		{"example.uk.com", "example", "uk.com"},
+		{"example.com.de", "example", "com.de"},
+		{"github.io", "github", "io"},
+		{"example.unknowntld", "example", "unknowntld"},
+		{"münchen.de", "xn--mnchen-3ya", "de"},
+		{"xn--mnchen-3ya.de", "xn--mnchen-3ya", "de"},
+		{"MÜNCHEN.DE", "xn--mnchen-3ya", "de"},
+		{"例え.jp", "xn--r8jz45g", "jp"},
+		{"example.рф", "example", "xn--p1ai"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.domain, func(t *testing.T) {
+			n, err := domainname.Parse(tt.domain)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if n.Label != tt.label || n.Extension != tt.extension {
+				t.Errorf("Expected %s + %s, got %s + %s", tt.label, tt.extension, n.Label, n.Extension)
+			}
+		})
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	tests := []string{
+		"",
+		".",
+		"example",
+		"com",
+		"co.uk",
+		"uk.com",
+		"www.example.com",
+		"www.example.co.uk",
+		"example.github.io",
+		"example..com",
+		".example.com",
+		"exa mple.com",
+		"-example.com",
+	}
+
+	for _, domain := range tests {
+		t.Run(domain, func(t *testing.T) {
+			_, err := domainname.Parse(domain)
+			if !errors.Is(err, domainname.ErrInvalidDomainName) {
+				t.Errorf("Expected ErrInvalidDomainName, got %v", err)
+			}
+		})
+	}
+}
+
+func TestNameString(t *testing.T) {
+	n, err := domainname.Parse("münchen.de")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if n.String() != "xn--mnchen-3ya.de" {
+		t.Errorf("Expected xn--mnchen-3ya.de, got %s", n.String())
+	}
+	if n.Unicode() != "münchen.de" {
+		t.Errorf("Expected münchen.de, got %s", n.Unicode())
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	for _, seed := range []string{
+		"example.com", "example.co.uk", "münchen.de", "xn--mnchen-3ya.de", "example.uk.com",
+		"www.example.com", "co.uk", "example", "", "例え.jp", "EXAMPLE.COM.",
+	} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, domain string) {
+		n, err := domainname.Parse(domain)
+		if err != nil {
+			if !errors.Is(err, domainname.ErrInvalidDomainName) {
+				t.Fatalf("Parse(%q) returned an untyped error: %v", domain, err)
+			}
+			return
+		}
+
+		if n.Label == "" || n.Extension == "" || strings.Contains(n.Label, ".") {
+			t.Fatalf("Parse(%q) returned an invalid split: %+v", domain, n)
+		}
+
+		// Both forms of a parsed name parse to the same name again
+		for _, form := range []string{n.String(), n.Unicode()} {
+			again, err := domainname.Parse(form)
+			if err != nil {
+				t.Fatalf("Parse(%q) failed for %q from %q: %v", form, n.String(), domain, err)
+			}
+			if again != n {
+				t.Fatalf("Parse(%q) = %+v, want %+v", form, again, n)
+			}
+		}
+	})
+}
@@ -168,7 +168,7 @@ func (d *CustomerDataSource) Read(ctx context.Context, req datasource.ReadReques
 	handle := config.Handle.ValueString()
 
 	// Get customer
-	customer, err := customers.Get(d.client, handle)
+	customer, err := customers.Get(ctx, d.client, handle)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Customer",
@@ -110,7 +110,7 @@ func (d *DNSZoneDataSource) Read(ctx context.Context, req datasource.ReadRequest
 
 	zoneName := config.ZoneName.ValueString()
 
-	zone, err := dnslib.GetZone(d.client, zoneName)
+	zone, err := dnslib.GetZone(ctx, d.client, zoneAPIName(zoneName))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading DNS zone",
@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/charpand/terraform-provider-openprovider/internal/client"
+	"github.com/charpand/terraform-provider-openprovider/internal/client/domains"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -22,6 +23,20 @@ type DomainDataSource struct {
 	client *client.Client
 }
 
+// DomainDataSourceModel describes the data source data model.
+type DomainDataSourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Domain         types.String `tfsdk:"domain"`
+	Status         types.String `tfsdk:"status"`
+	Autorenew      types.Bool   `tfsdk:"autorenew"`
+	OwnerHandle    types.String `tfsdk:"owner_handle"`
+	AdminHandle    types.String `tfsdk:"admin_handle"`
+	TechHandle     types.String `tfsdk:"tech_handle"`
+	BillingHandle  types.String `tfsdk:"billing_handle"`
+	Period         types.Int64  `tfsdk:"period"`
+	IsPrivateWhois types.Bool   `tfsdk:"is_private_whois_enabled"`
+}
+
 // NewDomainDataSource returns a new instance of the domain data source.
 func NewDomainDataSource() datasource.DataSource {
 	return &DomainDataSource{}
@@ -73,6 +88,10 @@ func (d *DomainDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				MarkdownDescription: "Registration period in years.",
 				Computed:            true,
 			},
+			"is_private_whois_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether WHOIS privacy protection is enabled for the domain.",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -97,7 +116,7 @@ func (d *DomainDataSource) Configure(_ context.Context, req datasource.Configure
 
 // Read retrieves the domain information.
 func (d *DomainDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var config DomainModel
+	var config DomainDataSourceModel
 	diags := req.Config.Get(ctx, &config)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -107,7 +126,7 @@ func (d *DomainDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	domainName := config.Domain.ValueString()
 
 	// Get domain by name
-	domain, err := getDomainByName(d.client, domainName)
+	domain, err := domains.GetByName(ctx, d.client, domainName)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Domain",
@@ -125,7 +144,7 @@ func (d *DomainDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	// Map to state
-	var state DomainModel
+	var state DomainDataSourceModel
 	state.ID = types.StringValue(domainName)
 	state.Domain = types.StringValue(domainName)
 	state.Status = types.StringValue(domain.Status)
@@ -143,6 +162,9 @@ func (d *DomainDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		state.Autorenew = types.BoolValue(false)
 	}
 
+	// Map WHOIS privacy
+	state.IsPrivateWhois = types.BoolValue(domain.IsPrivateWhois)
+
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
@@ -0,0 +1,233 @@
+// Package provider implements the Terraform provider for OpenProvider.
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client"
+	"github.com/charpand/terraform-provider-openprovider/internal/client/domains"
+	"github.com/charpand/terraform-provider-openprovider/internal/domainname"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &DomainCheckDataSource{}
+	_ datasource.DataSourceWithConfigure = &DomainCheckDataSource{}
+)
+
+// DomainCheckDataSource is the data source implementation.
+type DomainCheckDataSource struct {
+	client *client.Client
+}
+
+// DomainCheckDataSourceModel describes the data source data model.
+type DomainCheckDataSourceModel struct {
+	Domains   types.List                        `tfsdk:"domains"`
+	WithPrice types.Bool                        `tfsdk:"with_price"`
+	Results   map[string]DomainCheckResultModel `tfsdk:"results"`
+	ID        types.String                      `tfsdk:"id"`
+}
+
+// DomainCheckResultModel describes the availability of a single domain.
+type DomainCheckResultModel struct {
+	Status            types.String  `tfsdk:"status"`
+	Reason            types.String  `tfsdk:"reason"`
+	IsAvailable       types.Bool    `tfsdk:"is_available"`
+	IsPremium         types.Bool    `tfsdk:"is_premium"`
+	RegistrationPrice types.Float64 `tfsdk:"registration_price"`
+	RenewalPrice      types.Float64 `tfsdk:"renewal_price"`
+	TransferPrice     types.Float64 `tfsdk:"transfer_price"`
+	Currency          types.String  `tfsdk:"currency"`
+}
+
+// NewDomainCheckDataSource returns a new instance of the domain check data source.
+func NewDomainCheckDataSource() datasource.DataSource {
+	return &DomainCheckDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *DomainCheckDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_check"
+}
+
+// Schema defines the schema for the data source.
+func (d *DomainCheckDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Checks whether domains can be registered and what they cost. Use it in `precondition` blocks to stop an apply before registering an unavailable or premium-priced domain.",
+		Attributes: map[string]schema.Attribute{
+			"domains": schema.ListAttribute{
+				MarkdownDescription: "The domain names to check (e.g., example.com, example.co.uk or münchen.de).",
+				ElementType:         types.StringType,
+				Required:            true,
+			},
+			"with_price": schema.BoolAttribute{
+				MarkdownDescription: "Whether to look up registration, renewal and transfer prices. Defaults to `true`.",
+				Optional:            true,
+			},
+			"results": schema.MapNestedAttribute{
+				MarkdownDescription: "The check results, keyed by domain name as given in `domains`.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The availability of the domain: `free`, `active` (registered) or `reserved`.",
+							Computed:            true,
+						},
+						"reason": schema.StringAttribute{
+							MarkdownDescription: "The registry's reason why the domain is not free, if any.",
+							Computed:            true,
+						},
+						"is_available": schema.BoolAttribute{
+							MarkdownDescription: "Whether the domain can be registered, i.e. `status` is `free`.",
+							Computed:            true,
+						},
+						"is_premium": schema.BoolAttribute{
+							MarkdownDescription: "Whether the registry charges a premium price for the domain.",
+							Computed:            true,
+						},
+						"registration_price": schema.Float64Attribute{
+							MarkdownDescription: "The price of registering the domain for one year, in `currency`. Null when `with_price` is false.",
+							Computed:            true,
+						},
+						"renewal_price": schema.Float64Attribute{
+							MarkdownDescription: "The price of renewing the domain for one year, in `currency`. Null when `with_price` is false.",
+							Computed:            true,
+						},
+						"transfer_price": schema.Float64Attribute{
+							MarkdownDescription: "The price of transferring the domain, in `currency`. Null when `with_price` is false.",
+							Computed:            true,
+						},
+						"currency": schema.StringAttribute{
+							MarkdownDescription: "The currency of the prices charged to the reseller account.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The checked domain names, separated by commas.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *DomainCheckDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read checks the availability and prices of the configured domains.
+func (d *DomainCheckDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config DomainCheckDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var names []string
+	resp.Diagnostics.Append(config.Domains.ElementsAs(ctx, &names, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	withPrice := config.WithPrice.IsNull() || config.WithPrice.ValueBool()
+
+	results, err := domains.Check(ctx, d.client, names, withPrice)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error checking domains",
+			fmt.Sprintf("Could not check domains %s: %s", strings.Join(names, ", "), err.Error()),
+		)
+		return
+	}
+
+	// Results name domains in ASCII form, whatever form they were requested in
+	byDomain := make(map[string]domains.CheckResult, len(results))
+	for _, result := range results {
+		byDomain[strings.ToLower(result.Domain)] = result
+	}
+
+	config.Results = make(map[string]DomainCheckResultModel, len(names))
+	for _, name := range names {
+		parsed, err := domainname.Parse(name)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid Domain Name", err.Error())
+			return
+		}
+
+		result, ok := byDomain[parsed.String()]
+		if !ok {
+			resp.Diagnostics.AddError(
+				"Error checking domains",
+				fmt.Sprintf("The check returned no result for domain %s.", name),
+			)
+			return
+		}
+
+		model := DomainCheckResultModel{
+			Status:            types.StringValue(result.Status),
+			Reason:            types.StringNull(),
+			IsAvailable:       types.BoolValue(result.Status == domains.CheckStatusFree),
+			IsPremium:         types.BoolValue(result.IsPremium),
+			RegistrationPrice: types.Float64Null(),
+			RenewalPrice:      types.Float64Null(),
+			TransferPrice:     types.Float64Null(),
+			Currency:          types.StringNull(),
+		}
+		if result.Reason != "" {
+			model.Reason = types.StringValue(result.Reason)
+		}
+
+		if withPrice {
+			if result.Price != nil {
+				model.RegistrationPrice = types.Float64Value(result.Price.Reseller.Price)
+				model.Currency = types.StringValue(result.Price.Reseller.Currency)
+			}
+
+			// The check only quotes registrations, so renewals and transfers are
+			// priced separately.
+			for operation, price := range map[string]*types.Float64{
+				domains.OperationRenew:    &model.RenewalPrice,
+				domains.OperationTransfer: &model.TransferPrice,
+			} {
+				quote, err := domains.GetPrice(ctx, d.client, parsed.Label, parsed.Extension, operation, 1)
+				if err != nil {
+					resp.Diagnostics.AddError(
+						"Error checking domains",
+						fmt.Sprintf("Could not get the %s price of domain %s: %s", operation, name, err.Error()),
+					)
+					return
+				}
+				*price = types.Float64Value(quote.Price.Reseller.Price)
+				model.Currency = types.StringValue(quote.Price.Reseller.Currency)
+			}
+		}
+
+		config.Results[name] = model
+	}
+
+	config.ID = types.StringValue(strings.Join(names, ","))
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	resourcetest "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestDomainCheckDataSourceMetadata(t *testing.T) {
+	d := NewDomainCheckDataSource()
+	resp := &datasource.MetadataResponse{}
+	d.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "openprovider"}, resp)
+
+	if resp.TypeName != "openprovider_domain_check" {
+		t.Errorf("Expected TypeName openprovider_domain_check, got %s", resp.TypeName)
+	}
+}
+
+func TestAccDomainCheckDataSource(t *testing.T) {
+	stub := newStubServer(t)
+	stub.addPremium("xn--mnchen-3ya.de")
+
+	resourcetest.Test(t, resourcetest.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resourcetest.TestStep{
+			{
+				Config: stub.config(`
+data "openprovider_domain_check" "test" {
+  domains = ["example.co.uk", "example.com", "münchen.de"]
+}
+
+data "openprovider_domain_check" "without_price" {
+  domains    = ["example.co.uk"]
+  with_price = false
+}
+`),
+				Check: resourcetest.ComposeAggregateTestCheckFunc(
+					resourcetest.TestCheckResourceAttr("data.openprovider_domain_check.test", "id", "example.co.uk,example.com,münchen.de"),
+					resourcetest.TestCheckResourceAttr("data.openprovider_domain_check.test", "results.example.co.uk.is_available", "true"),
+					resourcetest.TestCheckResourceAttr("data.openprovider_domain_check.test", "results.example.co.uk.registration_price", "9.75"),
+					resourcetest.TestCheckResourceAttr("data.openprovider_domain_check.test", "results.example.co.uk.currency", "EUR"),
+					resourcetest.TestCheckResourceAttr("data.openprovider_domain_check.test", "results.example.com.is_available", "false"),
+					resourcetest.TestCheckResourceAttr("data.openprovider_domain_check.test", "results.example.com.reason", "Domain exists"),
+					resourcetest.TestCheckResourceAttr("data.openprovider_domain_check.test", "results.münchen.de.is_premium", "true"),
+					resourcetest.TestCheckNoResourceAttr("data.openprovider_domain_check.without_price", "results.example.co.uk.registration_price"),
+				),
+			},
+			{
+				Config: stub.config(`
+data "openprovider_domain_check" "test" {
+  domains = ["www.example.com"]
+}
+`),
+				ExpectError: regexp.MustCompile(`it is a subdomain of\s+example\.com`),
+			},
+		},
+	})
+}
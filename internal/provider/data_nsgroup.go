@@ -99,7 +99,7 @@ func (d *NSGroupDataSource) Read(ctx context.Context, req datasource.ReadRequest
 
 	groupName := config.Name.ValueString()
 
-	group, err := nsgroups.Get(d.client, groupName)
+	group, err := nsgroups.Get(ctx, d.client, groupName)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading NS Group",
@@ -125,7 +125,7 @@ func (d *SSLProductDataSource) Read(ctx context.Context, req datasource.ReadRequ
 
 	productID := int(config.ProductID.ValueInt64())
 
-	product, err := ssllib.GetProduct(d.client, productID)
+	product, err := ssllib.GetProduct(ctx, d.client, productID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading SSL product",
@@ -0,0 +1,118 @@
+// Package provider implements the Terraform provider for OpenProvider.
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client"
+	"github.com/charpand/terraform-provider-openprovider/internal/client/tlds"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &TLDDataSource{}
+	_ datasource.DataSourceWithConfigure = &TLDDataSource{}
+)
+
+// TLDDataSource is the data source implementation.
+type TLDDataSource struct {
+	client *client.Client
+}
+
+// TLDDataSourceModel describes the data source data model.
+type TLDDataSourceModel struct {
+	TLDModel
+	WithPrice types.Bool   `tfsdk:"with_price"`
+	ID        types.String `tfsdk:"id"`
+}
+
+// NewTLDDataSource returns a new instance of the TLD data source.
+func NewTLDDataSource() datasource.DataSource {
+	return &TLDDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *TLDDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tld"
+}
+
+// Schema defines the schema for the data source.
+func (d *TLDDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := tldAttributes()
+	attributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "The extension to look up, with or without a leading dot (e.g., com, .co.uk or рф).",
+		Required:            true,
+	}
+	attributes["with_price"] = schema.BoolAttribute{
+		MarkdownDescription: "Whether to look up the prices of the extension. Defaults to `true`.",
+		Optional:            true,
+	}
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "The extension in ASCII form.",
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the rules and prices of an extension sold by OpenProvider, such as its registration periods, DNSSEC and WHOIS privacy support and the additional data it requires.",
+		Attributes:          attributes,
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *TLDDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read retrieves the configured extension.
+func (d *TLDDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config TLDDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := config.Name.ValueString()
+	withPrice := config.WithPrice.IsNull() || config.WithPrice.ValueBool()
+
+	tld, err := tlds.Get(ctx, d.client, name, withPrice)
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"TLD Not Found",
+				fmt.Sprintf("OpenProvider does not sell the extension %s.", name),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading TLD",
+			fmt.Sprintf("Could not read extension %s: %s", name, err.Error()),
+		)
+		return
+	}
+
+	config.TLDModel = newTLDModel(tld)
+	// Keep the name as written in the configuration
+	config.Name = types.StringValue(name)
+	config.ID = types.StringValue(tld.Name)
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
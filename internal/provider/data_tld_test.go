@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	resourcetest "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestTLDDataSourceMetadata(t *testing.T) {
+	d := NewTLDDataSource()
+	resp := &datasource.MetadataResponse{}
+	d.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "openprovider"}, resp)
+
+	if resp.TypeName != "openprovider_tld" {
+		t.Errorf("Expected TypeName openprovider_tld, got %s", resp.TypeName)
+	}
+}
+
+func TestAccTLDDataSource(t *testing.T) {
+	stub := newStubServer(t)
+
+	resourcetest.Test(t, resourcetest.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resourcetest.TestStep{
+			{
+				Config: stub.config(`
+data "openprovider_tld" "us" {
+  name = ".US"
+}
+
+data "openprovider_tld" "de" {
+  name       = "de"
+  with_price = false
+}
+`),
+				Check: resourcetest.ComposeAggregateTestCheckFunc(
+					resourcetest.TestCheckResourceAttr("data.openprovider_tld.us", "id", "us"),
+					resourcetest.TestCheckResourceAttr("data.openprovider_tld.us", "description", "United States"),
+					resourcetest.TestCheckResourceAttr("data.openprovider_tld.us", "periods.#", "10"),
+					resourcetest.TestCheckResourceAttr("data.openprovider_tld.us", "is_private_whois_allowed", "false"),
+					resourcetest.TestCheckResourceAttr("data.openprovider_tld.us", "min_nameservers", "2"),
+					resourcetest.TestCheckResourceAttr("data.openprovider_tld.us", "required_additional_data.0", "nexus_category"),
+					resourcetest.TestCheckResourceAttr("data.openprovider_tld.us", "registration_price", "7.25"),
+					resourcetest.TestCheckResourceAttr("data.openprovider_tld.us", "currency", "EUR"),
+					resourcetest.TestCheckResourceAttr("data.openprovider_tld.de", "is_idn_allowed", "true"),
+					resourcetest.TestCheckResourceAttr("data.openprovider_tld.de", "required_additional_data.#", "0"),
+					resourcetest.TestCheckNoResourceAttr("data.openprovider_tld.de", "registration_price"),
+					resourcetest.TestCheckNoResourceAttr("data.openprovider_tld.de", "min_nameservers"),
+				),
+			},
+			{
+				Config: stub.config(`
+data "openprovider_tld" "test" {
+  name = "invalid"
+}
+`),
+				ExpectError: regexp.MustCompile("TLD Not Found"),
+			},
+		},
+	})
+}
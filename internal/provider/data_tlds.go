@@ -0,0 +1,188 @@
+// Package provider implements the Terraform provider for OpenProvider.
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client"
+	"github.com/charpand/terraform-provider-openprovider/internal/client/tlds"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &TLDsDataSource{}
+	_ datasource.DataSourceWithConfigure = &TLDsDataSource{}
+)
+
+// TLDsDataSource is the data source implementation.
+type TLDsDataSource struct {
+	client *client.Client
+}
+
+// TLDsDataSourceModel describes the data source data model.
+type TLDsDataSourceModel struct {
+	NamePattern         types.String `tfsdk:"name_pattern"`
+	Type                types.String `tfsdk:"type"`
+	DnssecAllowed       types.Bool   `tfsdk:"dnssec_allowed"`
+	IDNAllowed          types.Bool   `tfsdk:"idn_allowed"`
+	PrivateWhoisAllowed types.Bool   `tfsdk:"private_whois_allowed"`
+	Period              types.Int64  `tfsdk:"period"`
+	WithPrice           types.Bool   `tfsdk:"with_price"`
+	Names               []string     `tfsdk:"names"`
+	TLDs                []TLDModel   `tfsdk:"tlds"`
+	ID                  types.String `tfsdk:"id"`
+}
+
+// NewTLDsDataSource returns a new instance of the TLDs data source.
+func NewTLDsDataSource() datasource.DataSource {
+	return &TLDsDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *TLDsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tlds"
+}
+
+// Schema defines the schema for the data source.
+func (d *TLDsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the extensions that can be ordered at OpenProvider, optionally filtered by name, type and registry features.",
+		Attributes: map[string]schema.Attribute{
+			"name_pattern": schema.StringAttribute{
+				MarkdownDescription: "Only list extensions matching this pattern, where `*` acts as a wildcard (e.g., `co.*`).",
+				Optional:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Only list extensions of this type, e.g. `gTLD` or `ccTLD`. Compared case-insensitively.",
+				Optional:            true,
+			},
+			"dnssec_allowed": schema.BoolAttribute{
+				MarkdownDescription: "Only list extensions that do (`true`) or do not (`false`) support DNSSEC.",
+				Optional:            true,
+			},
+			"idn_allowed": schema.BoolAttribute{
+				MarkdownDescription: "Only list extensions that do (`true`) or do not (`false`) accept internationalized domain names.",
+				Optional:            true,
+			},
+			"private_whois_allowed": schema.BoolAttribute{
+				MarkdownDescription: "Only list extensions that do (`true`) or do not (`false`) allow WHOIS privacy protection.",
+				Optional:            true,
+			},
+			"period": schema.Int64Attribute{
+				MarkdownDescription: "Only list extensions that accept registrations for this many years.",
+				Optional:            true,
+			},
+			"with_price": schema.BoolAttribute{
+				MarkdownDescription: "Whether to look up the prices of the extensions. Defaults to `false`.",
+				Optional:            true,
+			},
+			"names": schema.ListAttribute{
+				MarkdownDescription: "The names of the matching extensions, in the order of `tlds`.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"tlds": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching extensions, ordered by name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: tldAttributes(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Placeholder identifier, always `tlds`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *TLDsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read lists the extensions matching the configured filters.
+func (d *TLDsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config TLDsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := &tlds.ListOptions{
+		NamePattern: config.NamePattern.ValueString(),
+		Status:      tlds.StatusActive,
+		WithPrice:   config.WithPrice.ValueBool(),
+	}
+
+	results, err := tlds.List(ctx, d.client, opts)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing TLDs",
+			fmt.Sprintf("Could not list extensions: %s", err.Error()),
+		)
+		return
+	}
+
+	// The API only filters by name and status; the other filters apply here
+	slices.SortFunc(results, func(a, b tlds.TLD) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	config.Names = []string{}
+	config.TLDs = []TLDModel{}
+	for i := range results {
+		tld := &results[i]
+		if !matchesTLDFilters(&config, tld) {
+			continue
+		}
+		config.Names = append(config.Names, tld.Name)
+		config.TLDs = append(config.TLDs, newTLDModel(tld))
+	}
+
+	config.ID = types.StringValue("tlds")
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
+
+// matchesTLDFilters reports whether tld passes the feature filters of config.
+// Unset filters match every extension.
+func matchesTLDFilters(config *TLDsDataSourceModel, tld *tlds.TLD) bool {
+	if !config.Type.IsNull() && !strings.EqualFold(config.Type.ValueString(), tld.Type) {
+		return false
+	}
+	if !config.DnssecAllowed.IsNull() && config.DnssecAllowed.ValueBool() != tld.IsDnssecAllowed {
+		return false
+	}
+	if !config.IDNAllowed.IsNull() && config.IDNAllowed.ValueBool() != tld.IsIDNAllowed() {
+		return false
+	}
+	if !config.PrivateWhoisAllowed.IsNull() && config.PrivateWhoisAllowed.ValueBool() != tld.IsPrivateWhoisAllowed {
+		return false
+	}
+	if !config.Period.IsNull() && !slices.Contains(tld.Periods(), int(config.Period.ValueInt64())) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	resourcetest "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestTLDsDataSourceMetadata(t *testing.T) {
+	d := NewTLDsDataSource()
+	resp := &datasource.MetadataResponse{}
+	d.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "openprovider"}, resp)
+
+	if resp.TypeName != "openprovider_tlds" {
+		t.Errorf("Expected TypeName openprovider_tlds, got %s", resp.TypeName)
+	}
+}
+
+func TestAccTLDsDataSource(t *testing.T) {
+	stub := newStubServer(t)
+
+	// Each filter reads the active extensions matching it, in name order
+	filters := []struct {
+		name   string
+		filter string
+		want   []string
+	}{
+		{"all", "", []string{"com", "de", "us"}},
+		{"name_pattern", `name_pattern = "*s"`, []string{"us"}},
+		{"type", `type = "cctld"`, []string{"de", "us"}},
+		{"idn", `idn_allowed = true`, []string{"com", "de"}},
+		{"no_private_whois", `private_whois_allowed = false`, []string{"de", "us"}},
+		{"period", `period = 2`, []string{"com", "us"}},
+		{"combined", "dnssec_allowed = true\n  type = \"ccTLD\"\n  period = 5", []string{"us"}},
+	}
+
+	var config strings.Builder
+	var checks []resourcetest.TestCheckFunc
+	for _, f := range filters {
+		fmt.Fprintf(&config, "\ndata \"openprovider_tlds\" %q {\n  %s\n}\n", f.name, f.filter)
+		address := "data.openprovider_tlds." + f.name
+		checks = append(checks,
+			resourcetest.TestCheckResourceAttr(address, "names.#", fmt.Sprint(len(f.want))),
+			resourcetest.TestCheckNoResourceAttr(address, "tlds.0.registration_price"),
+		)
+		for i, name := range f.want {
+			checks = append(checks, resourcetest.TestCheckResourceAttr(address, fmt.Sprintf("names.%d", i), name))
+		}
+	}
+	config.WriteString(`
+data "openprovider_tlds" "priced" {
+  name_pattern = "com"
+  with_price   = true
+}
+`)
+	checks = append(checks,
+		resourcetest.TestCheckResourceAttr("data.openprovider_tlds.priced", "tlds.0.registration_price", "9.75"),
+		resourcetest.TestCheckResourceAttr("data.openprovider_tlds.priced", "tlds.0.renewal_price", "11"),
+		resourcetest.TestCheckResourceAttr("data.openprovider_tlds.priced", "tlds.0.transfer_price", "8.5"),
+		resourcetest.TestCheckResourceAttr("data.openprovider_tlds.priced", "tlds.0.restore_price", "80"),
+		resourcetest.TestCheckResourceAttr("data.openprovider_tlds.priced", "tlds.0.currency", "EUR"),
+	)
+
+	resourcetest.Test(t, resourcetest.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resourcetest.TestStep{
+			{
+				Config: stub.config(config.String()),
+				Check:  resourcetest.ComposeAggregateTestCheckFunc(checks...),
+			},
+		},
+	})
+}
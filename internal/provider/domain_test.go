@@ -2,6 +2,8 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/charpand/terraform-provider-openprovider/internal/client/domains"
@@ -10,6 +12,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	resourcetest "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestDomainResourceSchema(t *testing.T) {
@@ -53,7 +57,7 @@ func TestDomainDataSourceSchema(t *testing.T) {
 		t.Fatal("Schema attributes should not be nil")
 	}
 
-	expectedAttrs := []string{"id", "domain", "status", "autorenew", "owner_handle", "admin_handle", "tech_handle", "billing_handle", "period"}
+	expectedAttrs := []string{"id", "domain", "status", "autorenew", "owner_handle", "admin_handle", "tech_handle", "billing_handle", "period", "is_private_whois_enabled"}
 	for _, attr := range expectedAttrs {
 		if _, ok := resp.Schema.Attributes[attr]; !ok {
 			t.Errorf("Expected attribute %s not found in schema", attr)
@@ -271,3 +275,453 @@ func TestConvertDnssecKeysToAPIHandlesNull(t *testing.T) {
 		})
 	}
 }
+
+func TestAccDomainResource(t *testing.T) {
+	stub := newStubServer(t)
+	config := func(locked, private bool, nameservers string) string {
+		return stub.config(fmt.Sprintf(`
+resource "openprovider_domain" "test" {
+  domain                   = "acme.com"
+  owner_handle             = "XX123456-XX"
+  is_locked                = %t
+  is_private_whois_enabled = %t
+
+  nameservers = [%s]
+}
+`, locked, private, nameservers))
+	}
+	route53 := `{ name = "ns-1.awsdns-01.org" }, { name = "ns-2.awsdns-02.net" }`
+	glue := `{ name = "ns1.acme.com", ip = "192.0.2.1", seq_nr = 1 }, { name = "ns2.example.net", seq_nr = 2 }`
+
+	// domainState checks the domain as registered at OpenProvider
+	domainState := func(locked, private bool, nameserver string) resourcetest.TestCheckFunc {
+		return func(*terraform.State) error {
+			domain, ok := stub.domain("acme.com")
+			if !ok {
+				return fmt.Errorf("expected acme.com to be registered")
+			}
+			if domain.IsLocked != locked || domain.IsPrivateWhois != private {
+				return fmt.Errorf("expected lock %v and WHOIS privacy %v, got %+v", locked, private, domain)
+			}
+			if len(domain.Nameservers) != 2 || domain.Nameservers[0].Name != nameserver {
+				return fmt.Errorf("expected nameservers starting with %s, got %+v", nameserver, domain.Nameservers)
+			}
+			return nil
+		}
+	}
+
+	resourcetest.Test(t, resourcetest.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resourcetest.TestStep{
+			{
+				Config: config(true, true, route53),
+				Check: resourcetest.ComposeAggregateTestCheckFunc(
+					resourcetest.TestCheckResourceAttr("openprovider_domain.test", "id", "acme.com"),
+					resourcetest.TestCheckResourceAttr("openprovider_domain.test", "status", domains.StatusActive),
+					resourcetest.TestCheckResourceAttr("openprovider_domain.test", "nameservers.#", "2"),
+					domainState(true, true, "ns-1.awsdns-01.org"),
+				),
+			},
+			// The numbering OpenProvider assigns to the nameservers is no drift
+			refreshWithoutChanges,
+			{
+				Config: config(false, false, glue),
+				Check:  domainState(false, false, "ns1.acme.com"),
+			},
+			{
+				// Changes made in the panel show up as drift and are reverted
+				PreConfig: func() {
+					stub.updateDomain("acme.com", func(domain *domains.Domain) {
+						domain.IsLocked = true
+						domain.Nameservers = []domains.Nameserver{{Name: "ns1.other.net", SeqNr: 1}, {Name: "ns2.other.net", SeqNr: 2}}
+					})
+				},
+				Config:             config(false, false, glue),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config(false, false, glue),
+				Check:  domainState(false, false, "ns1.acme.com"),
+			},
+			{
+				ResourceName:      "openprovider_domain.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Nameservers are only managed once configured
+				ImportStateVerifyIgnore: []string{"nameservers"},
+			},
+		},
+	})
+}
+
+func TestAccDomainResourceDomainNames(t *testing.T) {
+	stub := newStubServer(t)
+
+	// registered checks that the domain was registered under the given label and extension
+	registered := func(label, extension string) resourcetest.TestCheckFunc {
+		return func(*terraform.State) error {
+			if _, ok := stub.domain(label + "." + extension); !ok {
+				return fmt.Errorf("expected %s + %s to be registered", label, extension)
+			}
+			return nil
+		}
+	}
+
+	resourcetest.Test(t, resourcetest.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resourcetest.TestStep{
+			{
+				Config: stub.config(`
+resource "openprovider_domain" "multi_label" {
+  domain       = "example.co.uk"
+  owner_handle = "XX123456-XX"
+}
+
+resource "openprovider_domain" "idn" {
+  domain       = "münchen.de"
+  owner_handle = "XX123456-XX"
+}
+`),
+				Check: resourcetest.ComposeAggregateTestCheckFunc(
+					resourcetest.TestCheckResourceAttr("openprovider_domain.idn", "id", "münchen.de"),
+					registered("example", "co.uk"),
+					registered("xn--mnchen-3ya", "de"),
+				),
+			},
+			refreshWithoutChanges,
+		},
+	})
+}
+
+func TestAccDomainResourceValidation(t *testing.T) {
+	stub := newStubServer(t)
+	config := func(domain, attributes string) string {
+		return stub.config(fmt.Sprintf(`
+resource "openprovider_domain" "test" {
+  domain       = %q
+  owner_handle = "XX123456-XX"
+  %s
+}
+`, domain, attributes))
+	}
+
+	tests := []struct {
+		config    string
+		wantError string
+	}{
+		{config("www.example.com", ""), "Invalid Domain Name"},
+		{config("acme.com", `nameservers = [{ name = "ns1.example.net" }]`), "Invalid Number of Nameservers"},
+		{config("acme.com", `nameservers = [{ name = "ns1.acme.com", ip = "2001:db8::1" }, { name = "ns2.example.net" }]`), "Invalid Nameserver Address"},
+		{config("acme.com", `nameservers = [{ name = "ns1.example.net" }, { name = "ns2.example.net" }]
+  ns_group    = "my-group"`), "Invalid Attribute Combination"},
+		{config("acme.com", `deletion_mode = "purge"`), "Invalid Deletion Mode"},
+		{config("acme.de", `is_private_whois_enabled = true`), "WHOIS Privacy Not Supported"},
+		{config("acme.us", `additional_data = { nexus_category = "C99", application_purpose = "P1" }`), "Invalid Additional Data"},
+	}
+
+	steps := make([]resourcetest.TestStep, 0, len(tests))
+	for _, tt := range tests {
+		steps = append(steps, resourcetest.TestStep{
+			Config:      tt.config,
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile(tt.wantError),
+		})
+	}
+
+	resourcetest.Test(t, resourcetest.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps:                    steps,
+	})
+}
+
+func TestAccDomainResourceDeletion(t *testing.T) {
+	tests := []struct {
+		mode       string
+		wantExists bool
+		autorenew  string
+	}{
+		{domainDeletionStateOnly, true, "on"},
+		{domainDeletionDelete, false, ""},
+		{domainDeletionAtExpiry, true, "off"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.mode, func(t *testing.T) {
+			stub := newStubServer(t)
+
+			resourcetest.Test(t, resourcetest.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resourcetest.TestStep{
+					{
+						Config: stub.config(fmt.Sprintf(`
+resource "openprovider_domain" "test" {
+  domain         = "acme.com"
+  owner_handle   = "XX123456-XX"
+  autorenew      = true
+  allow_deletion = true
+  deletion_mode  = %q
+}
+`, tt.mode)),
+					},
+				},
+				CheckDestroy: func(*terraform.State) error {
+					domain, exists := stub.domain("acme.com")
+					if exists != tt.wantExists || domain.Autorenew != tt.autorenew {
+						return fmt.Errorf("expected domain to exist %v with autorenew %q, got %v with %+v", tt.wantExists, tt.autorenew, exists, domain)
+					}
+					return nil
+				},
+			})
+		})
+	}
+}
+
+func TestAccDomainResourceTransfer(t *testing.T) {
+	// config transfers acme.com, waiting for the transfer to complete if wait is set
+	config := func(stub *stubServer, wait bool, createTimeout string) string {
+		return stub.config(fmt.Sprintf(`
+resource "openprovider_domain" "test" {
+  domain                   = "acme.com"
+  auth_code                = "secret"
+  owner_handle             = "XX123456-XX"
+  is_private_whois_enabled = true
+  wait_for_transfer        = %t
+
+  timeouts {
+    create = %q
+  }
+}
+`, wait, createTimeout))
+	}
+
+	tests := []struct {
+		name       string
+		statuses   []string
+		timeout    string
+		wantError  string
+		wantStatus string
+	}{
+		{"Completed", []string{domains.StatusActive}, "1m", "", domains.StatusActive},
+		{"Rejected", []string{domains.StatusRejected}, "1m", "Domain Transfer Failed", ""},
+		{"Timeout", nil, "1s", "Timeout Waiting for Domain Transfer", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stub := newStubServer(t)
+			stub.queueStatuses("acme.com", tt.statuses...)
+
+			step := resourcetest.TestStep{Config: config(stub, true, tt.timeout)}
+			if tt.wantError != "" {
+				step.ExpectError = regexp.MustCompile(tt.wantError)
+			} else {
+				step.Check = resourcetest.TestCheckResourceAttr("openprovider_domain.test", "status", tt.wantStatus)
+			}
+
+			resourcetest.Test(t, resourcetest.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps:                    []resourcetest.TestStep{step},
+			})
+		})
+	}
+
+	t.Run("Without waiting", func(t *testing.T) {
+		stub := newStubServer(t)
+
+		resourcetest.Test(t, resourcetest.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resourcetest.TestStep{
+				{
+					// The pending transfer does not report WHOIS privacy yet
+					Config:             config(stub, false, "1m"),
+					Check:              resourcetest.TestCheckResourceAttr("openprovider_domain.test", "status", domains.StatusRequested),
+					ExpectNonEmptyPlan: true,
+				},
+				{
+					Config:      config(stub, false, "1m"),
+					ExpectError: regexp.MustCompile("WHOIS Privacy Not Applied"),
+				},
+			},
+		})
+	})
+}
+
+func TestAccDomainResourceOwnerChange(t *testing.T) {
+	stub := newStubServer(t)
+	config := func(owner string, accept bool) string {
+		return stub.config(fmt.Sprintf(`
+resource "openprovider_domain" "test" {
+  domain                   = "acme.com"
+  owner_handle             = %q
+  accept_owner_change_lock = %t
+}
+`, owner, accept))
+	}
+
+	resourcetest.Test(t, resourcetest.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resourcetest.TestStep{
+			{Config: config("OLD123", false)},
+			{
+				// Generic extensions need the transfer lock acknowledged
+				Config:      config("NEW123", false),
+				ExpectError: regexp.MustCompile("Owner Change Not Acknowledged"),
+			},
+			{
+				Config: config("NEW123", true),
+				Check: resourcetest.ComposeAggregateTestCheckFunc(
+					resourcetest.TestCheckResourceAttr("openprovider_domain.test", "owner_handle", "NEW123"),
+					resourcetest.TestCheckNoResourceAttr("openprovider_domain.test", "pending_owner_handle"),
+				),
+			},
+		},
+	})
+
+	if got := stub.countRequests("POST /v1beta/domains/trade"); got != 0 {
+		t.Errorf("Expected no trade, got %d", got)
+	}
+}
+
+func TestAccDomainResourceOwnerChangeTrade(t *testing.T) {
+	stub := newStubServer(t)
+	domainConfig := func(owner string) string {
+		return fmt.Sprintf(`
+resource "openprovider_domain" "test" {
+  domain       = "acme.nl"
+  owner_handle = %q
+
+  timeouts {
+    update = "1s"
+  }
+}
+`, owner)
+	}
+
+	resourcetest.Test(t, resourcetest.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resourcetest.TestStep{
+			{Config: stub.config(domainConfig("OLD123"))},
+			{
+				// Trades are billable
+				Config:      stub.configWithoutBillable(domainConfig("NEW123")),
+				ExpectError: regexp.MustCompile("Billable Operation Not Allowed"),
+			},
+			{
+				// The registrants have not confirmed the trade before the timeout
+				PreConfig:   stub.holdOwnerChanges,
+				Config:      stub.config(domainConfig("NEW123")),
+				ExpectError: regexp.MustCompile("Timeout Waiting for Owner Change"),
+			},
+			{
+				// Applying again waits for the pending trade instead of requesting another
+				PreConfig: stub.confirmOwnerChanges,
+				Config:    stub.config(domainConfig("NEW123")),
+				Check: resourcetest.ComposeAggregateTestCheckFunc(
+					resourcetest.TestCheckResourceAttr("openprovider_domain.test", "owner_handle", "NEW123"),
+					resourcetest.TestCheckNoResourceAttr("openprovider_domain.test", "pending_owner_handle"),
+				),
+			},
+		},
+	})
+
+	if got := stub.countRequests("POST /v1beta/domains/trade"); got != 1 {
+		t.Errorf("Expected a single trade, got %d", got)
+	}
+}
+
+func TestAccDomainResourcePremium(t *testing.T) {
+	stub := newStubServer(t)
+	stub.addPremium("premium.com")
+	config := func(accept string) string {
+		return stub.config(fmt.Sprintf(`
+resource "openprovider_domain" "test" {
+  domain       = "premium.com"
+  owner_handle = "XX123456-XX"
+  %s
+}
+`, accept))
+	}
+
+	resourcetest.Test(t, resourcetest.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resourcetest.TestStep{
+			{
+				Config:      config(""),
+				ExpectError: regexp.MustCompile("Premium Price Not Accepted"),
+			},
+			{
+				Config:      config(`accept_premium_price = { amount = 100, currency = "USD" }`),
+				ExpectError: regexp.MustCompile("Premium Price Not Accepted"),
+			},
+			{
+				Config: config(`accept_premium_price = { amount = 10, currency = "EUR" }`),
+				Check: resourcetest.ComposeAggregateTestCheckFunc(
+					resourcetest.TestCheckResourceAttr("openprovider_domain.test", "premium_price.amount", "9.75"),
+					resourcetest.TestCheckResourceAttr("openprovider_domain.test", "premium_price.currency", "EUR"),
+				),
+			},
+		},
+	})
+
+	if got := stub.countRequests("POST /v1beta/domains"); got != 1 {
+		t.Errorf("Expected 1 registration, got %d", got)
+	}
+}
+
+func TestAccDomainResourceAdditionalData(t *testing.T) {
+	stub := newStubServer(t)
+	config := func(additionalData string) string {
+		return stub.config(fmt.Sprintf(`
+resource "openprovider_domain" "test" {
+  domain       = "acme.us"
+  owner_handle = "XX123456-XX"
+  %s
+}
+`, additionalData))
+	}
+
+	resourcetest.Test(t, resourcetest.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resourcetest.TestStep{
+			{
+				Config:      config(""),
+				ExpectError: regexp.MustCompile("Invalid Additional Data"),
+			},
+			{
+				Config: config(`additional_data = { nexus_category = "C12", application_purpose = "P3" }`),
+				Check: func(*terraform.State) error {
+					if data := stub.sentAdditionalData("acme.us"); data == nil || data.NexusCategory != "C12" || data.ApplicationPurpose != "P3" {
+						return fmt.Errorf("expected the additional data to be sent, got %+v", data)
+					}
+					return nil
+				},
+			},
+			// The data is not read back, so it stays as configured
+			refreshWithoutChanges,
+		},
+	})
+}
+
+func TestAccDomainDataSource(t *testing.T) {
+	stub := newStubServer(t)
+
+	resourcetest.Test(t, resourcetest.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resourcetest.TestStep{
+			{
+				Config: stub.config(`
+data "openprovider_domain" "test" {
+  domain = "example.com"
+}
+`),
+				Check: resourcetest.ComposeAggregateTestCheckFunc(
+					resourcetest.TestCheckResourceAttr("data.openprovider_domain.test", "id", "example.com"),
+					resourcetest.TestCheckResourceAttr("data.openprovider_domain.test", "owner_handle", "XX123456-XX"),
+					resourcetest.TestCheckResourceAttr("data.openprovider_domain.test", "autorenew", "true"),
+					resourcetest.TestCheckResourceAttr("data.openprovider_domain.test", "is_private_whois_enabled", "true"),
+				),
+			},
+		},
+	})
+}
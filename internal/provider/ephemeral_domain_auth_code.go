@@ -0,0 +1,125 @@
+// Package provider implements the Terraform provider for OpenProvider.
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client"
+	"github.com/charpand/terraform-provider-openprovider/internal/client/domains"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &DomainAuthCodeEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &DomainAuthCodeEphemeralResource{}
+)
+
+// DomainAuthCodeEphemeralResource is the ephemeral resource implementation.
+type DomainAuthCodeEphemeralResource struct {
+	client *client.Client
+}
+
+// DomainAuthCodeModel describes the ephemeral resource data model.
+type DomainAuthCodeModel struct {
+	Domain   types.String `tfsdk:"domain"`
+	AuthCode types.String `tfsdk:"auth_code"`
+	Type     types.String `tfsdk:"type"`
+}
+
+// NewDomainAuthCodeEphemeralResource returns a new instance of the domain auth code ephemeral resource.
+func NewDomainAuthCodeEphemeralResource() ephemeral.EphemeralResource {
+	return &DomainAuthCodeEphemeralResource{}
+}
+
+// Metadata returns the ephemeral resource type name.
+func (e *DomainAuthCodeEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_auth_code"
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (e *DomainAuthCodeEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves the EPP/authorization code of a domain, needed to transfer it to another registrar. The code is never stored in the plan or state. Use `openprovider_domain_auth_code_reset` to generate a new code. Requires Terraform 1.10 or later.",
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The domain name (e.g., example.com).",
+				Required:            true,
+			},
+			"auth_code": schema.StringAttribute{
+				MarkdownDescription: "The auth code of the domain.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the auth code as reported by OpenProvider, e.g. `internal` or `external` (issued by the registry).",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the ephemeral resource.
+func (e *DomainAuthCodeEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	e.client = client
+}
+
+// Open retrieves the auth code of the domain.
+func (e *DomainAuthCodeEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var config DomainAuthCodeModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domainName := config.Domain.ValueString()
+
+	domain, err := domains.GetByName(ctx, e.client, domainName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Domain",
+			fmt.Sprintf("Could not read domain %s: %s", domainName, err.Error()),
+		)
+		return
+	}
+	if domain == nil {
+		resp.Diagnostics.AddError(
+			"Domain Not Found",
+			fmt.Sprintf("Domain %s not found", domainName),
+		)
+		return
+	}
+
+	code, err := domains.GetAuthCode(ctx, e.client, domain.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Auth Code",
+			fmt.Sprintf("Could not get the auth code of domain %s: %s", domainName, err.Error()),
+		)
+		return
+	}
+
+	config.AuthCode = types.StringValue(code.AuthCode)
+	config.Type = types.StringNull()
+	if code.Type != "" {
+		config.Type = types.StringValue(code.Type)
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &config)...)
+}
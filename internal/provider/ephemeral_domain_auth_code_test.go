@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	resourcetest "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestDomainAuthCodeEphemeralResourceMetadata(t *testing.T) {
+	e := NewDomainAuthCodeEphemeralResource()
+	resp := &ephemeral.MetadataResponse{}
+	e.Metadata(context.Background(), ephemeral.MetadataRequest{ProviderTypeName: "openprovider"}, resp)
+
+	if resp.TypeName != "openprovider_domain_auth_code" {
+		t.Errorf("Expected TypeName openprovider_domain_auth_code, got %s", resp.TypeName)
+	}
+}
+
+func TestAccDomainAuthCodeEphemeralResource(t *testing.T) {
+	stub := newStubServer(t)
+	config := func(domain string) string {
+		// The echo provider copies the ephemeral result into state for checking
+		return stub.config(`
+ephemeral "openprovider_domain_auth_code" "test" {
+  domain = "` + domain + `"
+}
+
+provider "echo" {
+  data = ephemeral.openprovider_domain_auth_code.test
+}
+
+resource "echo" "test" {}
+`)
+	}
+
+	resourcetest.Test(t, resourcetest.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"openprovider": testAccProtoV6ProviderFactories["openprovider"],
+			"echo":         echoprovider.NewProviderServer(),
+		},
+		Steps: []resourcetest.TestStep{
+			{
+				Config:      config("acme.com"),
+				ExpectError: regexp.MustCompile("Domain Not Found"),
+			},
+			{
+				Config: config("example.com"),
+				Check: resourcetest.ComposeAggregateTestCheckFunc(
+					resourcetest.TestCheckResourceAttr("echo.test", "data.auth_code", "current"),
+					resourcetest.TestCheckResourceAttr("echo.test", "data.type", "internal"),
+				),
+			},
+		},
+	})
+
+	if got := stub.countRequests("POST /v1beta/domains/42/authcode/reset"); got != 0 {
+		t.Errorf("Expected no reset, got %d", got)
+	}
+}
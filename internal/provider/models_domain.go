@@ -2,26 +2,66 @@
 package provider
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // DomainModel represents the Terraform state model for a domain.
 // This is separate from the API model and uses Terraform framework types.
 type DomainModel struct {
-	ID              types.String `tfsdk:"id"`
-	Domain          types.String `tfsdk:"domain"`
-	AuthCode        types.String `tfsdk:"auth_code"`
-	Status          types.String `tfsdk:"status"`
-	Autorenew       types.Bool   `tfsdk:"autorenew"`
-	OwnerHandle     types.String `tfsdk:"owner_handle"`
-	AdminHandle     types.String `tfsdk:"admin_handle"`
-	TechHandle      types.String `tfsdk:"tech_handle"`
-	BillingHandle   types.String `tfsdk:"billing_handle"`
-	Period          types.Int64  `tfsdk:"period"`
-	NSGroup         types.String `tfsdk:"ns_group"`
-	DnssecKeys      types.List   `tfsdk:"dnssec_keys"`
-	IsDnssecEnabled types.Bool   `tfsdk:"is_dnssec_enabled"`
-	ExpirationDate  types.String `tfsdk:"expiration_date"`
+	ID                    types.String   `tfsdk:"id"`
+	Domain                types.String   `tfsdk:"domain"`
+	AuthCode              types.String   `tfsdk:"auth_code"`
+	Status                types.String   `tfsdk:"status"`
+	Autorenew             types.Bool     `tfsdk:"autorenew"`
+	OwnerHandle           types.String   `tfsdk:"owner_handle"`
+	AcceptOwnerChangeLock types.Bool     `tfsdk:"accept_owner_change_lock"`
+	PendingOwnerHandle    types.String   `tfsdk:"pending_owner_handle"`
+	AdminHandle           types.String   `tfsdk:"admin_handle"`
+	TechHandle            types.String   `tfsdk:"tech_handle"`
+	BillingHandle         types.String   `tfsdk:"billing_handle"`
+	Period                types.Int64    `tfsdk:"period"`
+	NSGroup               types.String   `tfsdk:"ns_group"`
+	Nameservers           types.Set      `tfsdk:"nameservers"`
+	DnssecKeys            types.List     `tfsdk:"dnssec_keys"`
+	IsDnssecEnabled       types.Bool     `tfsdk:"is_dnssec_enabled"`
+	IsLocked              types.Bool     `tfsdk:"is_locked"`
+	IsPrivateWhois        types.Bool     `tfsdk:"is_private_whois_enabled"`
+	AdditionalData        types.Object   `tfsdk:"additional_data"`
+	AcceptPremiumPrice    types.Object   `tfsdk:"accept_premium_price"`
+	PremiumPrice          types.Object   `tfsdk:"premium_price"`
+	ExpirationDate        types.String   `tfsdk:"expiration_date"`
+	AllowDeletion         types.Bool     `tfsdk:"allow_deletion"`
+	DeletionMode          types.String   `tfsdk:"deletion_mode"`
+	WaitForTransfer       types.Bool     `tfsdk:"wait_for_transfer"`
+	Timeouts              timeouts.Value `tfsdk:"timeouts"`
+}
+
+// NameserverModel represents a nameserver of a domain in Terraform state.
+type NameserverModel struct {
+	Name  types.String `tfsdk:"name"`
+	IP    types.String `tfsdk:"ip"`
+	IP6   types.String `tfsdk:"ip6"`
+	SeqNr types.Int64  `tfsdk:"seq_nr"`
+}
+
+// AdditionalDataModel represents the registry-specific registrant data of a domain
+// in Terraform state.
+type AdditionalDataModel struct {
+	ApplicationPurpose   types.String `tfsdk:"application_purpose"`
+	CountryOfCitizenship types.String `tfsdk:"country_of_citizenship"`
+	EntityType           types.String `tfsdk:"entity_type"`
+	IDNumber             types.String `tfsdk:"id_number"`
+	IDType               types.String `tfsdk:"id_type"`
+	LegalType            types.String `tfsdk:"legal_type"`
+	NexusCategory        types.String `tfsdk:"nexus_category"`
+	VAT                  types.String `tfsdk:"vat"`
+}
+
+// PremiumPriceModel represents a premium registration price in Terraform state.
+type PremiumPriceModel struct {
+	Amount   types.Float64 `tfsdk:"amount"`
+	Currency types.String  `tfsdk:"currency"`
 }
 
 // DnssecKeyModel represents a DNSSEC key in Terraform state.
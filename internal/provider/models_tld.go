@@ -0,0 +1,165 @@
+// Package provider implements the Terraform provider for OpenProvider.
+package provider
+
+import (
+	"github.com/charpand/terraform-provider-openprovider/internal/client/tlds"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// TLDModel represents an extension and the rules of its registry in Terraform state.
+type TLDModel struct {
+	Name                       types.String  `tfsdk:"name"`
+	Status                     types.String  `tfsdk:"status"`
+	Type                       types.String  `tfsdk:"type"`
+	Description                types.String  `tfsdk:"description"`
+	Periods                    []int64       `tfsdk:"periods"`
+	MinPeriod                  types.Int64   `tfsdk:"min_period"`
+	MaxPeriod                  types.Int64   `tfsdk:"max_period"`
+	IsDnssecAllowed            types.Bool    `tfsdk:"is_dnssec_allowed"`
+	IsIDNAllowed               types.Bool    `tfsdk:"is_idn_allowed"`
+	IsPrivateWhoisAllowed      types.Bool    `tfsdk:"is_private_whois_allowed"`
+	MinNameservers             types.Int64   `tfsdk:"min_nameservers"`
+	MaxNameservers             types.Int64   `tfsdk:"max_nameservers"`
+	IsTransferAuthCodeRequired types.Bool    `tfsdk:"is_transfer_auth_code_required"`
+	RequiredAdditionalData     []string      `tfsdk:"required_additional_data"`
+	RegistrationPrice          types.Float64 `tfsdk:"registration_price"`
+	RenewalPrice               types.Float64 `tfsdk:"renewal_price"`
+	TransferPrice              types.Float64 `tfsdk:"transfer_price"`
+	RestorePrice               types.Float64 `tfsdk:"restore_price"`
+	Currency                   types.String  `tfsdk:"currency"`
+}
+
+// newTLDModel maps an extension from the API to its Terraform model. Prices are
+// null unless the API returned them.
+func newTLDModel(tld *tlds.TLD) TLDModel {
+	model := TLDModel{
+		Name:                       types.StringValue(tld.Name),
+		Status:                     types.StringValue(tld.Status),
+		Type:                       types.StringValue(tld.Type),
+		Description:                types.StringNull(),
+		Periods:                    []int64{},
+		MinPeriod:                  types.Int64Value(int64(tld.MinPeriod)),
+		MaxPeriod:                  types.Int64Value(int64(tld.MaxPeriod)),
+		IsDnssecAllowed:            types.BoolValue(tld.IsDnssecAllowed),
+		IsIDNAllowed:               types.BoolValue(tld.IsIDNAllowed()),
+		IsPrivateWhoisAllowed:      types.BoolValue(tld.IsPrivateWhoisAllowed),
+		MinNameservers:             types.Int64Null(),
+		MaxNameservers:             types.Int64Null(),
+		IsTransferAuthCodeRequired: types.BoolValue(tld.IsTransferAuthCodeRequired),
+		RequiredAdditionalData:     []string{},
+		RegistrationPrice:          types.Float64Null(),
+		RenewalPrice:               types.Float64Null(),
+		TransferPrice:              types.Float64Null(),
+		RestorePrice:               types.Float64Null(),
+		Currency:                   types.StringNull(),
+	}
+
+	if tld.Description != "" {
+		model.Description = types.StringValue(tld.Description)
+	}
+	for _, period := range tld.Periods() {
+		model.Periods = append(model.Periods, int64(period))
+	}
+	if tld.MinNameservers > 0 {
+		model.MinNameservers = types.Int64Value(int64(tld.MinNameservers))
+	}
+	if tld.MaxNameservers > 0 {
+		model.MaxNameservers = types.Int64Value(int64(tld.MaxNameservers))
+	}
+	model.RequiredAdditionalData = append(model.RequiredAdditionalData, tld.RequiredAdditionalData...)
+
+	if prices := tld.Prices; prices != nil {
+		model.RegistrationPrice = types.Float64Value(prices.CreatePrice.Reseller.Price)
+		model.RenewalPrice = types.Float64Value(prices.RenewPrice.Reseller.Price)
+		model.TransferPrice = types.Float64Value(prices.TransferPrice.Reseller.Price)
+		model.RestorePrice = types.Float64Value(prices.RestorePrice.Reseller.Price)
+		model.Currency = types.StringValue(prices.CreatePrice.Reseller.Currency)
+	}
+
+	return model
+}
+
+// tldAttributes returns the computed schema attributes of TLDModel.
+func tldAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The extension without a leading dot, in ASCII form (e.g., com, co.uk or xn--p1ai).",
+			Computed:            true,
+		},
+		"status": schema.StringAttribute{
+			MarkdownDescription: "The status of the extension. `ACT` for extensions that can be ordered.",
+			Computed:            true,
+		},
+		"type": schema.StringAttribute{
+			MarkdownDescription: "The kind of extension, e.g. `gTLD` or `ccTLD`.",
+			Computed:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "A description of the extension, if OpenProvider has one.",
+			Computed:            true,
+		},
+		"periods": schema.ListAttribute{
+			MarkdownDescription: "The registration periods in years that the registry accepts, from `min_period` to `max_period`.",
+			ElementType:         types.Int64Type,
+			Computed:            true,
+		},
+		"min_period": schema.Int64Attribute{
+			MarkdownDescription: "The shortest registration period in years.",
+			Computed:            true,
+		},
+		"max_period": schema.Int64Attribute{
+			MarkdownDescription: "The longest registration period in years.",
+			Computed:            true,
+		},
+		"is_dnssec_allowed": schema.BoolAttribute{
+			MarkdownDescription: "Whether domains under the extension can be signed with DNSSEC.",
+			Computed:            true,
+		},
+		"is_idn_allowed": schema.BoolAttribute{
+			MarkdownDescription: "Whether internationalized domain names (e.g., münchen.de) can be registered under the extension.",
+			Computed:            true,
+		},
+		"is_private_whois_allowed": schema.BoolAttribute{
+			MarkdownDescription: "Whether WHOIS privacy protection can be enabled for domains under the extension.",
+			Computed:            true,
+		},
+		"min_nameservers": schema.Int64Attribute{
+			MarkdownDescription: "The fewest nameservers a domain must have. Null when the registry sets no minimum.",
+			Computed:            true,
+		},
+		"max_nameservers": schema.Int64Attribute{
+			MarkdownDescription: "The most nameservers a domain can have. Null when the registry sets no maximum.",
+			Computed:            true,
+		},
+		"is_transfer_auth_code_required": schema.BoolAttribute{
+			MarkdownDescription: "Whether transferring a domain under the extension requires an auth code.",
+			Computed:            true,
+		},
+		"required_additional_data": schema.ListAttribute{
+			MarkdownDescription: "The `additional_data` fields of `openprovider_domain` that a registration under the extension needs, e.g. `nexus_category` for .us. Empty when none are needed.",
+			ElementType:         types.StringType,
+			Computed:            true,
+		},
+		"registration_price": schema.Float64Attribute{
+			MarkdownDescription: "The price of registering a domain for one year, in `currency`. Null when `with_price` is false.",
+			Computed:            true,
+		},
+		"renewal_price": schema.Float64Attribute{
+			MarkdownDescription: "The price of renewing a domain for one year, in `currency`. Null when `with_price` is false.",
+			Computed:            true,
+		},
+		"transfer_price": schema.Float64Attribute{
+			MarkdownDescription: "The price of transferring a domain, in `currency`. Null when `with_price` is false.",
+			Computed:            true,
+		},
+		"restore_price": schema.Float64Attribute{
+			MarkdownDescription: "The price of restoring a deleted domain from quarantine, in `currency`. Null when `with_price` is false.",
+			Computed:            true,
+		},
+		"currency": schema.StringAttribute{
+			MarkdownDescription: "The currency of the prices charged to the reseller account.",
+			Computed:            true,
+		},
+	}
+}
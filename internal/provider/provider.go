@@ -3,15 +3,27 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/charpand/terraform-provider-openprovider/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// Ensure the implementation satisfies the expected interfaces.
+var _ provider.ProviderWithEphemeralResources = &OpenproviderProvider{}
+
 // OpenproviderProvider defines the provider implementation.
 // OpenproviderProvider implements the Terraform provider and holds provider-level configuration.
 type OpenproviderProvider struct {
@@ -21,8 +33,18 @@ type OpenproviderProvider struct {
 
 // OpenproviderProviderModel describes the provider data model.
 type OpenproviderProviderModel struct {
-	Username types.String `tfsdk:"username"`
-	Password types.String `tfsdk:"password"`
+	Environment             types.String `tfsdk:"environment"`
+	AllowBillableOperations types.String `tfsdk:"allow_billable_operations"`
+	Username                types.String `tfsdk:"username"`
+	Password                types.String `tfsdk:"password"`
+	Token                   types.String `tfsdk:"token"`
+	BaseURL                 types.String `tfsdk:"base_url"`
+	IPAddress               types.String `tfsdk:"ip_address"`
+	RequestTimeout          types.Int64  `tfsdk:"request_timeout"`
+	InsecureSkipVerify      types.Bool   `tfsdk:"insecure_skip_verify"`
+	MaxRetries              types.Int64  `tfsdk:"max_retries"`
+	RetryMaxWait            types.Int64  `tfsdk:"retry_max_wait"`
+	ReadCacheTTL            types.Int64  `tfsdk:"read_cache_ttl"`
 }
 
 // Metadata sets the provider type name and version.
@@ -35,15 +57,56 @@ func (p *OpenproviderProvider) Metadata(_ context.Context, _ provider.MetadataRe
 func (p *OpenproviderProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"environment": schema.StringAttribute{
+				MarkdownDescription: "The OpenProvider environment to use: `production` or `sandbox` (the OpenProvider test environment, CTE). Selects the API endpoint and, for `sandbox`, the `OPENPROVIDER_SANDBOX_*` credential environment variables. Can also be set with the `OPENPROVIDER_ENVIRONMENT` environment variable. Defaults to `production`.",
+				Optional:            true,
+			},
+			"allow_billable_operations": schema.StringAttribute{
+				MarkdownDescription: "The environment in which billable or irreversible operations, such as registering, transferring, renewing, trading or deleting domains and ordering SSL certificates, are allowed: `production` or `sandbox`. These operations are refused unless this matches `environment`. Can also be set with the `OPENPROVIDER_ALLOW_BILLABLE_OPERATIONS` environment variable.",
+				Optional:            true,
+			},
 			"username": schema.StringAttribute{
-				MarkdownDescription: "OpenProvider username.",
-				Required:            true,
+				MarkdownDescription: "OpenProvider username. Can also be set with the `OPENPROVIDER_USERNAME` environment variable. Required together with `password` unless `token` is set.",
+				Optional:            true,
 			},
 			"password": schema.StringAttribute{
-				MarkdownDescription: "OpenProvider password.",
-				Required:            true,
+				MarkdownDescription: "OpenProvider password. Can also be set with the `OPENPROVIDER_PASSWORD` environment variable. Required together with `username` unless `token` is set.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "OpenProvider API token, used instead of logging in with `username` and `password`. Can also be set with the `OPENPROVIDER_TOKEN` environment variable. When credentials are configured as well, they are used to log in again once the token expires.",
+				Optional:            true,
 				Sensitive:           true,
 			},
+			"base_url": schema.StringAttribute{
+				MarkdownDescription: "Root URL of the OpenProvider API, e.g. to target a local stub API. Can also be set with the `OPENPROVIDER_BASE_URL` environment variable. Defaults to `https://api.openprovider.eu`.",
+				Optional:            true,
+			},
+			"ip_address": schema.StringAttribute{
+				MarkdownDescription: "IP address sent when logging in, for accounts that restrict API access to whitelisted addresses. Defaults to `0.0.0.0`.",
+				Optional:            true,
+			},
+			"request_timeout": schema.Int64Attribute{
+				MarkdownDescription: "Timeout in seconds of a single API request. Defaults to 30.",
+				Optional:            true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Skip TLS certificate verification of the API, e.g. for a local stub API with a self-signed certificate. Never enable this against the real API. Defaults to false.",
+				Optional:            true,
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of retries for rate-limited (429) and transient (502, 503, 504) API responses. Requests that may place orders are only retried when OpenProvider reports that it did not process them. Set to 0 to disable retries. Defaults to 3.",
+				Optional:            true,
+			},
+			"retry_max_wait": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of seconds to wait between retries, including waits requested by a `Retry-After` header. Defaults to 30.",
+				Optional:            true,
+			},
+			"read_cache_ttl": schema.Int64Attribute{
+				MarkdownDescription: "Number of seconds API read responses are shared between resources within a single Terraform run. Identical concurrent reads are coalesced into one request and changes made by the provider invalidate affected entries. Reduces refreshes of large DNS zones from one zone listing per record to one per zone. Defaults to 0 (disabled).",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -58,37 +121,198 @@ func (p *OpenproviderProvider) Configure(ctx context.Context, req provider.Confi
 		return
 	}
 
-	// Validation
-	var username, password string
+	// Settings taken from other resources are unknown until those are applied.
+	// Without the environment, endpoint and credentials no client can be built yet
+	var unknown []string
+	for name, value := range map[string]types.String{
+		"environment": data.Environment,
+		"username":    data.Username,
+		"password":    data.Password,
+		"token":       data.Token,
+		"base_url":    data.BaseURL,
+	} {
+		if value.IsUnknown() {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) > 0 {
+		if req.ClientCapabilities.DeferralAllowed {
+			resp.Deferred = &provider.Deferred{Reason: provider.DeferredReasonProviderConfigUnknown}
+			return
+		}
+		slices.Sort(unknown)
+		for _, name := range unknown {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Unknown Provider Setting",
+				fmt.Sprintf("The provider cannot connect to OpenProvider while %s is unknown, for instance because it is taken from a resource that is not created yet. "+
+					"Set it to a static value or an environment variable, or apply the resource it is taken from first with -target.", name),
+			)
+		}
+		return
+	}
+
+	// Configuration values take precedence over environment variables
+	environment := stringValueOrEnv(data.Environment, "OPENPROVIDER_ENVIRONMENT")
+	if environment == "" {
+		environment = client.EnvironmentProduction
+	}
+
+	// Sandbox credentials are kept apart from production ones, so a workspace
+	// switched to the sandbox never picks up production credentials by accident
+	envPrefix := "OPENPROVIDER_"
+	if environment == client.EnvironmentSandbox {
+		envPrefix = "OPENPROVIDER_SANDBOX_"
+	}
+
+	username := stringValueOrEnv(data.Username, envPrefix+"USERNAME")
+	password := stringValueOrEnv(data.Password, envPrefix+"PASSWORD")
+	token := stringValueOrEnv(data.Token, envPrefix+"TOKEN")
+	baseURL := strings.TrimSuffix(stringValueOrEnv(data.BaseURL, envPrefix+"BASE_URL"), "/")
+	allowBillable := stringValueOrEnv(data.AllowBillableOperations, "OPENPROVIDER_ALLOW_BILLABLE_OPERATIONS")
 
-	if !data.Username.IsNull() {
-		username = data.Username.ValueString()
+	// Validation
+	if client.BaseURLForEnvironment(environment) == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("environment"),
+			"Invalid Environment",
+			fmt.Sprintf("environment must be one of %s, got %q.", strings.Join(client.Environments(), ", "), environment),
+		)
 	}
 
-	if !data.Password.IsNull() {
-		password = data.Password.ValueString()
+	if allowBillable != "" && client.BaseURLForEnvironment(allowBillable) == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("allow_billable_operations"),
+			"Invalid Billable Operations Configuration",
+			fmt.Sprintf("allow_billable_operations must be one of %s, got %q.", strings.Join(client.Environments(), ", "), allowBillable),
+		)
 	}
 
-	if username == "" || password == "" {
+	if (username == "") != (password == "") {
+		resp.Diagnostics.AddError(
+			"Incomplete Authentication Configuration",
+			"The provider requires both username and password when logging in with credentials. "+
+				"Set both, or authenticate with a token instead.",
+		)
+	} else if token == "" && username == "" {
 		resp.Diagnostics.AddError(
 			"Missing Authentication Configuration",
-			"The provider requires both username and password for authentication.",
+			"The provider requires either a token or a username and password for authentication. "+
+				fmt.Sprintf("Set them in the provider configuration or with the %sTOKEN, "+
+					"%sUSERNAME and %sPASSWORD environment variables.", envPrefix, envPrefix, envPrefix),
 		)
 	}
 
+	if baseURL != "" {
+		if u, err := url.Parse(baseURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("base_url"),
+				"Invalid Base URL",
+				"base_url must be an absolute http or https URL, such as https://api.openprovider.eu.",
+			)
+		}
+	}
+
+	var timeout time.Duration
+
+	// Unknown values use the defaults while planning
+	if !data.RequestTimeout.IsNull() && !data.RequestTimeout.IsUnknown() {
+		if data.RequestTimeout.ValueInt64() < 1 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("request_timeout"),
+				"Invalid Timeout Configuration",
+				"request_timeout must be at least 1 second.",
+			)
+		}
+		timeout = time.Duration(data.RequestTimeout.ValueInt64()) * time.Second
+	}
+
+	retry := client.DefaultRetryPolicy()
+
+	if !data.MaxRetries.IsNull() && !data.MaxRetries.IsUnknown() {
+		if data.MaxRetries.ValueInt64() < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("max_retries"),
+				"Invalid Retry Configuration",
+				"max_retries must be zero or greater.",
+			)
+		}
+		retry.MaxRetries = int(data.MaxRetries.ValueInt64())
+	}
+
+	if !data.RetryMaxWait.IsNull() && !data.RetryMaxWait.IsUnknown() {
+		if data.RetryMaxWait.ValueInt64() < 1 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("retry_max_wait"),
+				"Invalid Retry Configuration",
+				"retry_max_wait must be at least 1 second.",
+			)
+		}
+		retry.MaxDelay = time.Duration(data.RetryMaxWait.ValueInt64()) * time.Second
+	}
+
+	var cacheTTL time.Duration
+
+	if !data.ReadCacheTTL.IsNull() && !data.ReadCacheTTL.IsUnknown() {
+		if data.ReadCacheTTL.ValueInt64() < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("read_cache_ttl"),
+				"Invalid Cache Configuration",
+				"read_cache_ttl must be zero or greater.",
+			)
+		}
+		cacheTTL = time.Duration(data.ReadCacheTTL.ValueInt64()) * time.Second
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Client initialization
 	c := client.NewClient(client.Config{
-		Username: username,
-		Password: password,
+		Environment:             environment,
+		AllowBillableOperations: allowBillable,
+		// Billable operations are checked again during apply, when the setting is known
+		DeferBillableCheck: data.AllowBillableOperations.IsUnknown(),
+
+		BaseURL:            baseURL,
+		Username:           username,
+		Password:           password,
+		Token:              token,
+		IPAddress:          data.IPAddress.ValueString(),
+		Timeout:            timeout,
+		InsecureSkipVerify: data.InsecureSkipVerify.ValueBool(),
+		Retry:              &retry,
+		CacheTTL:           cacheTTL,
 	})
 
 	// Make client available
 	resp.DataSourceData = c
 	resp.ResourceData = c
+	resp.EphemeralResourceData = c
+}
+
+// stringValueOrEnv returns the configured value, or the environment variable env
+// when the value is not set in the configuration.
+func stringValueOrEnv(value types.String, env string) string {
+	if !value.IsNull() && !value.IsUnknown() {
+		return value.ValueString()
+	}
+	return os.Getenv(env)
+}
+
+// requireBillable adds an error to diags and returns false unless the client
+// allows billable operations in its environment.
+func requireBillable(c *client.Client, operation string, diags *diag.Diagnostics) bool {
+	if err := c.CheckBillable(operation); err != nil {
+		diags.AddError(
+			"Billable Operation Not Allowed",
+			fmt.Sprintf("%s. This guards workspaces against placing orders in an unintended environment; "+
+				"set allow_billable_operations in the provider configuration to the environment in which they are intended.", err.Error()),
+		)
+		return false
+	}
+	return true
 }
 
 // Resources returns the provider's resources.
@@ -96,8 +320,12 @@ func (p *OpenproviderProvider) Resources(_ context.Context) []func() resource.Re
 	return []func() resource.Resource{
 		NewCustomerResource,
 		NewDomainResource,
+		NewDomainRenewalResource,
+		NewDomainAuthCodeResetResource,
 		NewNSGroupResource,
 		NewDNSRecordResource,
+		NewDNSZoneResource,
+		NewDNSRecordsResource,
 		NewSSLOrderResource,
 	}
 }
@@ -107,9 +335,19 @@ func (p *OpenproviderProvider) DataSources(_ context.Context) []func() datasourc
 	return []func() datasource.DataSource{
 		NewCustomerDataSource,
 		NewDomainDataSource,
+		NewDomainCheckDataSource,
 		NewNSGroupDataSource,
 		NewDNSZoneDataSource,
 		NewSSLProductDataSource,
+		NewTLDDataSource,
+		NewTLDsDataSource,
+	}
+}
+
+// EphemeralResources returns the provider's ephemeral resources.
+func (p *OpenproviderProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewDomainAuthCodeEphemeralResource,
 	}
 }
 
@@ -3,10 +3,34 @@ package provider
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/charpand/terraform-provider-openprovider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	resourcetest "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
+// testAccProtoV6ProviderFactories serves the provider to Terraform core in
+// acceptance tests, which run it against a stubServer.
+var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"openprovider": providerserver.NewProtocol6WithError(New("test")()),
+}
+
+// refreshWithoutChanges is a test step that refreshes the state and expects the
+// refreshed state to match the configuration.
+var refreshWithoutChanges = resourcetest.TestStep{
+	RefreshState: true,
+	RefreshPlanChecks: resourcetest.RefreshPlanChecks{
+		PostRefresh: []plancheck.PlanCheck{plancheck.ExpectEmptyPlan()},
+	},
+}
+
 func TestProviderSchema(t *testing.T) {
 	ctx := context.Background()
 	p := New("test")()
@@ -17,10 +41,251 @@ func TestProviderSchema(t *testing.T) {
 		t.Fatal("Schema attributes should not be nil")
 	}
 
-	expectedAttrs := []string{"username", "password"}
+	expectedAttrs := []string{
+		"environment", "allow_billable_operations", "username", "password", "token", "base_url", "ip_address", "request_timeout",
+		"insecure_skip_verify", "max_retries", "retry_max_wait", "read_cache_ttl",
+	}
 	for _, attr := range expectedAttrs {
 		if _, ok := resp.Schema.Attributes[attr]; !ok {
 			t.Errorf("Expected attribute %s not found in schema", attr)
 		}
 	}
 }
+
+// configureProvider runs Configure with the given configuration values; omitted
+// attributes are null.
+func configureProvider(t *testing.T, values map[string]tftypes.Value) *provider.ConfigureResponse {
+	t.Helper()
+	resp := &provider.ConfigureResponse{}
+	New("test")().Configure(context.Background(), configureRequest(t, values), resp)
+	return resp
+}
+
+// requireNoErrors fails the test if diags contains an error.
+func requireNoErrors(t *testing.T, diags diag.Diagnostics) {
+	t.Helper()
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+}
+
+// configureRequest returns a Configure request with the given configuration
+// values; omitted attributes are null.
+func configureRequest(t *testing.T, values map[string]tftypes.Value) provider.ConfigureRequest {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResp := &provider.SchemaResponse{}
+	New("test")().Schema(ctx, provider.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	attrs := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attrType := range objectType.AttributeTypes {
+		if v, ok := values[name]; ok {
+			attrs[name] = v
+		} else {
+			attrs[name] = tftypes.NewValue(attrType, nil)
+		}
+	}
+
+	return provider.ConfigureRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, attrs)},
+	}
+}
+
+// clearProviderEnv unsets the provider's environment variables for the test.
+func clearProviderEnv(t *testing.T) {
+	for _, env := range []string{
+		"OPENPROVIDER_ENVIRONMENT", "OPENPROVIDER_ALLOW_BILLABLE_OPERATIONS",
+		"OPENPROVIDER_USERNAME", "OPENPROVIDER_PASSWORD", "OPENPROVIDER_TOKEN", "OPENPROVIDER_BASE_URL",
+		"OPENPROVIDER_SANDBOX_USERNAME", "OPENPROVIDER_SANDBOX_PASSWORD", "OPENPROVIDER_SANDBOX_TOKEN", "OPENPROVIDER_SANDBOX_BASE_URL",
+	} {
+		t.Setenv(env, "")
+	}
+}
+
+func TestProviderConfigureFromEnvironment(t *testing.T) {
+	clearProviderEnv(t)
+	t.Setenv("OPENPROVIDER_TOKEN", "env-token")
+	t.Setenv("OPENPROVIDER_BASE_URL", "http://localhost:4010/")
+
+	resp := configureProvider(t, nil)
+	requireNoErrors(t, resp.Diagnostics)
+
+	c := resp.ResourceData.(*client.Client)
+	if c.BaseURL != "http://localhost:4010" {
+		t.Errorf("Expected base URL from environment, got %s", c.BaseURL)
+	}
+	if c.Token() != "env-token" {
+		t.Errorf("Expected token from environment, got %s", c.Token())
+	}
+
+	// Configuration takes precedence over the environment
+	resp = configureProvider(t, map[string]tftypes.Value{
+		"token":           tftypes.NewValue(tftypes.String, "config-token"),
+		"request_timeout": tftypes.NewValue(tftypes.Number, 5),
+		"ip_address":      tftypes.NewValue(tftypes.String, "192.0.2.10"),
+	})
+	requireNoErrors(t, resp.Diagnostics)
+
+	c = resp.ResourceData.(*client.Client)
+	if c.Token() != "config-token" {
+		t.Errorf("Expected token from configuration, got %s", c.Token())
+	}
+	if c.HTTPClient.Timeout != 5*time.Second {
+		t.Errorf("Expected request timeout 5s, got %s", c.HTTPClient.Timeout)
+	}
+	if c.IPAddress != "192.0.2.10" {
+		t.Errorf("Expected IP address 192.0.2.10, got %s", c.IPAddress)
+	}
+}
+
+func TestProviderConfigureValidation(t *testing.T) {
+	tests := []struct {
+		name      string
+		env       map[string]string
+		values    map[string]tftypes.Value
+		wantError bool
+	}{
+		{"No credentials", nil, nil, true},
+		{"Username and password", nil, map[string]tftypes.Value{
+			"username": tftypes.NewValue(tftypes.String, "user"),
+			"password": tftypes.NewValue(tftypes.String, "pass"),
+		}, false},
+		{"Username and password from environment", map[string]string{
+			"OPENPROVIDER_USERNAME": "user",
+			"OPENPROVIDER_PASSWORD": "pass",
+		}, nil, false},
+		{"Username without password", nil, map[string]tftypes.Value{
+			"username": tftypes.NewValue(tftypes.String, "user"),
+		}, true},
+		{"Token", nil, map[string]tftypes.Value{
+			"token": tftypes.NewValue(tftypes.String, "token"),
+		}, false},
+		{"Invalid base URL", nil, map[string]tftypes.Value{
+			"token":    tftypes.NewValue(tftypes.String, "token"),
+			"base_url": tftypes.NewValue(tftypes.String, "api.openprovider.eu"),
+		}, true},
+		{"Invalid environment", nil, map[string]tftypes.Value{
+			"token":       tftypes.NewValue(tftypes.String, "token"),
+			"environment": tftypes.NewValue(tftypes.String, "staging"),
+		}, true},
+		{"Invalid allow_billable_operations", nil, map[string]tftypes.Value{
+			"token":                     tftypes.NewValue(tftypes.String, "token"),
+			"allow_billable_operations": tftypes.NewValue(tftypes.String, "yes"),
+		}, true},
+		{"Invalid allow_billable_operations from environment", map[string]string{
+			"OPENPROVIDER_TOKEN":                     "token",
+			"OPENPROVIDER_ALLOW_BILLABLE_OPERATIONS": "yes",
+		}, nil, true},
+		{"Sandbox ignores production credentials", map[string]string{
+			"OPENPROVIDER_TOKEN": "production-token",
+		}, map[string]tftypes.Value{
+			"environment": tftypes.NewValue(tftypes.String, "sandbox"),
+		}, true},
+		{"Invalid request timeout", nil, map[string]tftypes.Value{
+			"token":           tftypes.NewValue(tftypes.String, "token"),
+			"request_timeout": tftypes.NewValue(tftypes.Number, 0),
+		}, true},
+		{"Unknown request timeout", nil, map[string]tftypes.Value{
+			"token":           tftypes.NewValue(tftypes.String, "token"),
+			"request_timeout": tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+		}, false},
+		{"Unknown token", nil, map[string]tftypes.Value{
+			"token": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clearProviderEnv(t)
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+
+			resp := configureProvider(t, tt.values)
+			if resp.Diagnostics.HasError() != tt.wantError {
+				t.Errorf("Expected error=%v, got %v", tt.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestProviderConfigureUnknownBillableOperations(t *testing.T) {
+	clearProviderEnv(t)
+
+	resp := configureProvider(t, map[string]tftypes.Value{
+		"token":                     tftypes.NewValue(tftypes.String, "token"),
+		"allow_billable_operations": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+	})
+	requireNoErrors(t, resp.Diagnostics)
+
+	// The check happens again during apply, when the setting is known
+	if err := resp.ResourceData.(*client.Client).CheckBillable("domain registration"); err != nil {
+		t.Errorf("Expected the billable check to be deferred, got %v", err)
+	}
+}
+
+func TestProviderConfigureUnknownCredentialsDeferred(t *testing.T) {
+	clearProviderEnv(t)
+
+	req := configureRequest(t, map[string]tftypes.Value{
+		"token": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+	})
+	req.ClientCapabilities.DeferralAllowed = true
+	resp := &provider.ConfigureResponse{}
+	New("test")().Configure(context.Background(), req, resp)
+	requireNoErrors(t, resp.Diagnostics)
+
+	if resp.Deferred == nil || resp.Deferred.Reason != provider.DeferredReasonProviderConfigUnknown {
+		t.Errorf("Expected the configuration to be deferred, got %v", resp.Deferred)
+	}
+	if resp.ResourceData != nil {
+		t.Error("Expected no client")
+	}
+}
+
+func TestProviderConfigureEnvironment(t *testing.T) {
+	clearProviderEnv(t)
+	t.Setenv("OPENPROVIDER_TOKEN", "production-token")
+	t.Setenv("OPENPROVIDER_SANDBOX_TOKEN", "sandbox-token")
+
+	resp := configureProvider(t, nil)
+	requireNoErrors(t, resp.Diagnostics)
+	c := resp.ResourceData.(*client.Client)
+	if c.Environment != client.EnvironmentProduction || c.BaseURL != client.DefaultBaseURL || c.Token() != "production-token" {
+		t.Errorf("Expected production defaults, got environment %s, base URL %s, token %s", c.Environment, c.BaseURL, c.Token())
+	}
+	if c.CheckBillable("domain registration") == nil {
+		t.Error("Expected billable operations to be refused without allow_billable_operations")
+	}
+
+	t.Setenv("OPENPROVIDER_ALLOW_BILLABLE_OPERATIONS", "production")
+	resp = configureProvider(t, nil)
+	requireNoErrors(t, resp.Diagnostics)
+	if err := resp.ResourceData.(*client.Client).CheckBillable("domain registration"); err != nil {
+		t.Errorf("Expected allow_billable_operations from the environment, got %v", err)
+	}
+
+	// Configuration takes precedence over the environment
+	resp = configureProvider(t, map[string]tftypes.Value{
+		"allow_billable_operations": tftypes.NewValue(tftypes.String, "sandbox"),
+	})
+	requireNoErrors(t, resp.Diagnostics)
+	if resp.ResourceData.(*client.Client).CheckBillable("domain registration") == nil {
+		t.Error("Expected the configured allow_billable_operations to take precedence")
+	}
+
+	t.Setenv("OPENPROVIDER_ENVIRONMENT", "sandbox")
+	resp = configureProvider(t, map[string]tftypes.Value{
+		"allow_billable_operations": tftypes.NewValue(tftypes.String, "sandbox"),
+	})
+	requireNoErrors(t, resp.Diagnostics)
+	c = resp.ResourceData.(*client.Client)
+	if c.Environment != client.EnvironmentSandbox || c.BaseURL != client.SandboxBaseURL || c.Token() != "sandbox-token" {
+		t.Errorf("Expected sandbox settings, got environment %s, base URL %s, token %s", c.Environment, c.BaseURL, c.Token())
+	}
+	if err := c.CheckBillable("domain registration"); err != nil {
+		t.Errorf("Expected billable operations to be allowed in the sandbox, got %v", err)
+	}
+}
@@ -251,7 +251,7 @@ func (r *CustomerResource) Create(ctx context.Context, req resource.CreateReques
 	}
 
 	// Create the customer
-	handle, err := customers.Create(r.client, createReq)
+	handle, err := customers.Create(ctx, r.client, createReq)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Creating Customer",
@@ -281,7 +281,7 @@ func (r *CustomerResource) Read(ctx context.Context, req resource.ReadRequest, r
 	handle := state.Handle.ValueString()
 
 	// Get customer
-	customer, err := customers.Get(r.client, handle)
+	customer, err := customers.Get(ctx, r.client, handle)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Customer",
@@ -404,7 +404,7 @@ func (r *CustomerResource) Update(ctx context.Context, req resource.UpdateReques
 	}
 
 	// Send update
-	err := customers.Update(r.client, handle, updateReq)
+	err := customers.Update(ctx, r.client, handle, updateReq)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating Customer",
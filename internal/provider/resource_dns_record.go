@@ -3,21 +3,27 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/charpand/terraform-provider-openprovider/internal/client"
 	"github.com/charpand/terraform-provider-openprovider/internal/client/dns"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &DNSRecordResource{}
-	_ resource.ResourceWithConfigure = &DNSRecordResource{}
+	_ resource.Resource                = &DNSRecordResource{}
+	_ resource.ResourceWithConfigure   = &DNSRecordResource{}
+	_ resource.ResourceWithImportState = &DNSRecordResource{}
 )
 
 // DNSRecordResource is the resource implementation.
@@ -55,8 +61,11 @@ func (r *DNSRecordResource) Schema(_ context.Context, _ resource.SchemaRequest,
 		MarkdownDescription: "Manages a DNS record in a zone.",
 		Attributes: map[string]schema.Attribute{
 			"zone_name": schema.StringAttribute{
-				MarkdownDescription: "The name of the DNS zone containing this record (e.g., example.com).",
+				MarkdownDescription: "The name of the DNS zone containing this record (e.g., example.com). Changing this forces a new record.",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "The name of the DNS record (e.g., www, mail, @ for root).",
@@ -91,7 +100,7 @@ func (r *DNSRecordResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Computed:            true,
 			},
 			"id": schema.StringAttribute{
-				MarkdownDescription: "Identifier for the DNS record (composite of zone_name, name, type, and value).",
+				MarkdownDescription: "Identifier for the DNS record in the format `zone_name/name/type/value`.",
 				Computed:            true,
 			},
 			"allow_deletion": schema.BoolAttribute{
@@ -140,7 +149,7 @@ func (r *DNSRecordResource) Create(ctx context.Context, req resource.CreateReque
 		Priority: int(plan.Priority.ValueInt64()),
 	}
 
-	record, err := dns.CreateRecord(r.client, zoneName, createReq)
+	record, err := dns.CreateRecord(ctx, r.client, zoneName, createReq)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating DNS record",
@@ -150,7 +159,7 @@ func (r *DNSRecordResource) Create(ctx context.Context, req resource.CreateReque
 	}
 
 	// Map response to state
-	plan.ID = types.StringValue(fmt.Sprintf("%s/%s/%s", zoneName, record.Name, record.Type))
+	plan.ID = types.StringValue(dnsRecordID(zoneName, record))
 	plan.CreationDate = types.StringValue(record.CreationDate)
 	plan.ModificationDate = types.StringValue(record.ModificationDate)
 	plan.TTL = types.Int64Value(int64(record.TTL))
@@ -171,11 +180,15 @@ func (r *DNSRecordResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	zoneName := state.ZoneName.ValueString()
-	recordName := state.Name.ValueString()
-	recordType := state.Type.ValueString()
 
-	record, err := dns.GetRecord(r.client, zoneName, recordName, recordType)
+	// Records in a record set share name and type, so the record is identified
+	// by its full tuple to avoid reading another value of the same set.
+	record, err := dns.GetRecord(ctx, r.client, zoneName, recordFromState(state).Key())
 	if err != nil {
+		if errors.Is(err, dns.ErrRecordNotFound) || client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error reading DNS record",
 			fmt.Sprintf("Could not read DNS record: %s", err.Error()),
@@ -189,7 +202,7 @@ func (r *DNSRecordResource) Read(ctx context.Context, req resource.ReadRequest,
 	state.Priority = types.Int64Value(int64(record.Priority))
 	state.CreationDate = types.StringValue(record.CreationDate)
 	state.ModificationDate = types.StringValue(record.ModificationDate)
-	state.ID = types.StringValue(fmt.Sprintf("%s/%s/%s", zoneName, record.Name, record.Type))
+	state.ID = types.StringValue(dnsRecordID(zoneName, record))
 
 	// Set state
 	diags = resp.State.Set(ctx, state)
@@ -199,8 +212,12 @@ func (r *DNSRecordResource) Read(ctx context.Context, req resource.ReadRequest,
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *DNSRecordResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var plan DNSRecordModel
+	var state DNSRecordModel
+
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -214,7 +231,7 @@ func (r *DNSRecordResource) Update(ctx context.Context, req resource.UpdateReque
 		Priority: int(plan.Priority.ValueInt64()),
 	}
 
-	record, err := dns.UpdateRecord(r.client, zoneName, plan.Name.ValueString(), plan.Type.ValueString(), updateReq)
+	record, err := dns.UpdateRecord(ctx, r.client, zoneName, recordFromState(state), updateReq)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating DNS record",
@@ -223,10 +240,15 @@ func (r *DNSRecordResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	// The update response carries no record, so fetch the dates of the new one
+	if current, err := dns.GetRecord(ctx, r.client, zoneName, record.Key()); err == nil {
+		record = current
+	}
+
 	// Update state
 	plan.CreationDate = types.StringValue(record.CreationDate)
 	plan.ModificationDate = types.StringValue(record.ModificationDate)
-	plan.ID = types.StringValue(fmt.Sprintf("%s/%s/%s", zoneName, record.Name, record.Type))
+	plan.ID = types.StringValue(dnsRecordID(zoneName, record))
 
 	// Set state
 	diags = resp.State.Set(ctx, plan)
@@ -245,7 +267,6 @@ func (r *DNSRecordResource) Delete(ctx context.Context, req resource.DeleteReque
 	zoneName := state.ZoneName.ValueString()
 	recordName := state.Name.ValueString()
 	recordType := state.Type.ValueString()
-	recordValue := state.Value.ValueString()
 
 	// Check if deletion is allowed
 	allowDeletion := !state.AllowDeletion.IsNull() && state.AllowDeletion.ValueBool()
@@ -255,16 +276,20 @@ func (r *DNSRecordResource) Delete(ctx context.Context, req resource.DeleteReque
 		resp.Diagnostics.AddWarning(
 			"DNS Record Removed from Terraform State Only",
 			fmt.Sprintf("DNS record %s.%s (%s) has been removed from your Terraform state but NOT deleted in OpenProvider. "+
-				"The record still exists and can be reimported. "+
+				"The record still exists and can be reimported with the ID %s. "+
 				"To enable deletion, set allow_deletion = true on the resource.",
-				recordName, zoneName, recordType),
+				recordName, zoneName, recordType, state.ID.ValueString()),
 		)
 		return
 	}
 
-	// Proceed with deletion since allow_deletion is true
-	err := dns.DeleteRecord(r.client, zoneName, recordName, recordType, recordValue)
-	if err != nil {
+	// Proceed with deletion since allow_deletion is true. The record is removed by
+	// its full tuple so that other values of the same record set are kept.
+	updates := dns.RecordUpdates{Remove: []dns.Record{recordFromState(state)}}
+	if err := dns.UpdateRecords(ctx, r.client, zoneName, updates); err != nil {
+		if client.IsNotFound(err) {
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error deleting DNS record",
 			fmt.Sprintf("Could not delete DNS record: %s", err.Error()),
@@ -272,3 +297,96 @@ func (r *DNSRecordResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 }
+
+// ImportState imports an existing resource into Terraform.
+func (r *DNSRecordResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	zoneName, recordName, recordType, recordValue, err := parseDNSRecordID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	// The priority is part of the record's identity but not of the import ID, so
+	// the record is looked up in its record set before Read takes over.
+	records, err := dns.FindRecords(ctx, r.client, zoneName, recordName, recordType)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing DNS record",
+			fmt.Sprintf("Could not read DNS records in zone %s: %s", zoneName, err.Error()),
+		)
+		return
+	}
+
+	var matches []dns.Record
+	var values []string
+	for _, record := range records {
+		values = append(values, record.Value)
+		if recordValue == "" || record.Value == recordValue {
+			matches = append(matches, record)
+		}
+	}
+
+	switch {
+	case len(matches) == 0:
+		resp.Diagnostics.AddError(
+			"DNS Record Not Found",
+			fmt.Sprintf("No %s record %q with the given value exists in zone %s.", recordType, recordName, zoneName),
+		)
+		return
+	case len(matches) > 1 && recordValue == "":
+		resp.Diagnostics.AddError(
+			"Ambiguous Import ID",
+			fmt.Sprintf("The %s record set %q in zone %s has %d values (%s). Import one of them with an ID in the format zone/name/type/value.",
+				recordType, recordName, zoneName, len(matches), strings.Join(values, ", ")),
+		)
+		return
+	case len(matches) > 1:
+		resp.Diagnostics.AddError(
+			"Ambiguous Import ID",
+			fmt.Sprintf("The %s record set %q in zone %s has %d records with value %q that differ only in priority; import is not supported for these records.",
+				recordType, recordName, zoneName, len(matches), recordValue),
+		)
+		return
+	}
+
+	record := matches[0]
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), dnsRecordID(zoneName, &record))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone_name"), zoneName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), record.Name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("type"), record.Type)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("value"), record.Value)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ttl"), int64(record.TTL))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("priority"), int64(record.Priority))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("allow_deletion"), false)...)
+}
+
+// parseDNSRecordID splits an import ID in the format zone/name/type[/value]. The
+// value is optional and may itself contain slashes.
+func parseDNSRecordID(id string) (zoneName, recordName, recordType, recordValue string, err error) {
+	parts := strings.SplitN(id, "/", 4)
+	if len(parts) < 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", "", fmt.Errorf("expected an import ID in the format zone/name/type or zone/name/type/value, got %q", id)
+	}
+	if len(parts) == 4 {
+		recordValue = parts[3]
+	}
+
+	zoneName = strings.TrimSuffix(strings.ToLower(parts[0]), ".")
+	return zoneName, parts[1], strings.ToUpper(parts[2]), recordValue, nil
+}
+
+// recordFromState converts the record held in state to its API representation.
+func recordFromState(state DNSRecordModel) dns.Record {
+	return dns.Record{
+		Name:     state.Name.ValueString(),
+		Type:     state.Type.ValueString(),
+		Value:    state.Value.ValueString(),
+		TTL:      int(state.TTL.ValueInt64()),
+		Priority: int(state.Priority.ValueInt64()),
+	}
+}
+
+// dnsRecordID returns the resource ID of a record: zone/name/type/value.
+func dnsRecordID(zoneName string, record *dns.Record) string {
+	return fmt.Sprintf("%s/%s/%s/%s", zoneName, record.Name, record.Type, record.Value)
+}
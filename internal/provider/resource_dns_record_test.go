@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"testing"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client/dns"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourcetest "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestDNSRecordResourceMetadata(t *testing.T) {
+	r := NewDNSRecordResource()
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "openprovider"}, resp)
+
+	if resp.TypeName != "openprovider_dns_record" {
+		t.Errorf("Expected TypeName openprovider_dns_record, got %s", resp.TypeName)
+	}
+}
+
+func TestAccDNSRecordResource(t *testing.T) {
+	stub := newStubServer(t)
+	stub.addZone("example.com", dns.Record{Name: "@", Type: "MX", Value: "mx.example.com", TTL: 3600, Priority: 10})
+
+	// Two resources manage values of the same record set
+	config := func(second string) string {
+		return stub.config(fmt.Sprintf(`
+resource "openprovider_dns_record" "first" {
+  zone_name      = "example.com"
+  name           = "www"
+  type           = "A"
+  value          = "192.0.2.1"
+  allow_deletion = true
+}
+
+resource "openprovider_dns_record" "second" {
+  zone_name      = "example.com"
+  name           = "www"
+  type           = "A"
+  value          = %q
+  ttl            = 300
+  allow_deletion = true
+}
+`, second))
+	}
+
+	// zoneHas checks the values of the www record set, in any order
+	zoneHas := func(values ...string) resourcetest.TestCheckFunc {
+		return func(*terraform.State) error {
+			var got []string
+			for _, record := range stub.zoneRecords("example.com") {
+				if record.Name == "www" {
+					got = append(got, record.Value)
+				}
+			}
+			slices.Sort(got)
+			if fmt.Sprint(got) != fmt.Sprint(values) {
+				return fmt.Errorf("expected values %v, got %v", values, got)
+			}
+			return nil
+		}
+	}
+
+	resourcetest.Test(t, resourcetest.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resourcetest.TestStep{
+			{
+				Config: config("192.0.2.2"),
+				Check: resourcetest.ComposeAggregateTestCheckFunc(
+					resourcetest.TestCheckResourceAttr("openprovider_dns_record.first", "id", "example.com/www/A/192.0.2.1"),
+					resourcetest.TestCheckResourceAttr("openprovider_dns_record.second", "id", "example.com/www/A/192.0.2.2"),
+					zoneHas("192.0.2.1", "192.0.2.2"),
+				),
+			},
+			// Each resource reads its own value instead of the first one in the set
+			refreshWithoutChanges,
+			{
+				// Changing one value replaces that record only
+				Config: config("192.0.2.3"),
+				Check:  zoneHas("192.0.2.1", "192.0.2.3"),
+			},
+			{
+				ResourceName:      "openprovider_dns_record.second",
+				ImportState:       true,
+				ImportStateId:     "Example.com./www/a/192.0.2.3",
+				ImportStateVerify: true,
+				// A safeguard that defaults to false on import
+				ImportStateVerifyIgnore: []string{"allow_deletion"},
+			},
+			{
+				// Without a value, a single-value record set is imported with its priority
+				Config: config("192.0.2.3") + `
+import {
+  to = openprovider_dns_record.mx
+  id = "example.com/@/MX"
+}
+
+resource "openprovider_dns_record" "mx" {
+  zone_name = "example.com"
+  name      = "@"
+  type      = "MX"
+  value     = "mx.example.com"
+  priority  = 10
+}
+`,
+				Check: resourcetest.TestCheckResourceAttr("openprovider_dns_record.mx", "priority", "10"),
+			},
+			{
+				ResourceName:  "openprovider_dns_record.second",
+				ImportState:   true,
+				ImportStateId: "example.com/www/A",
+				ExpectError:   regexp.MustCompile("Ambiguous Import ID"),
+			},
+		},
+		// The MX record is kept without allow_deletion
+		CheckDestroy: func(*terraform.State) error {
+			if records := stub.zoneRecords("example.com"); len(records) != 1 || records[0].Type != "MX" {
+				return fmt.Errorf("expected only the MX record to remain, got %+v", records)
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,399 @@
+// Package provider implements the Terraform provider for OpenProvider.
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client"
+	"github.com/charpand/terraform-provider-openprovider/internal/client/dns"
+	"github.com/charpand/terraform-provider-openprovider/internal/domainname"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &DNSRecordsResource{}
+	_ resource.ResourceWithConfigure      = &DNSRecordsResource{}
+	_ resource.ResourceWithImportState    = &DNSRecordsResource{}
+	_ resource.ResourceWithValidateConfig = &DNSRecordsResource{}
+)
+
+// DNSRecordsResource is the resource implementation.
+type DNSRecordsResource struct {
+	client *client.Client
+}
+
+// DNSRecordsModel describes the resource data model.
+type DNSRecordsModel struct {
+	ZoneName      types.String           `tfsdk:"zone_name"`
+	Records       []DNSRecordsEntryModel `tfsdk:"records"`
+	ID            types.String           `tfsdk:"id"`
+	AllowDeletion types.Bool             `tfsdk:"allow_deletion"`
+}
+
+// DNSRecordsEntryModel describes a single record managed by the resource.
+type DNSRecordsEntryModel struct {
+	Name     types.String `tfsdk:"name"`
+	Type     types.String `tfsdk:"type"`
+	Value    types.String `tfsdk:"value"`
+	TTL      types.Int64  `tfsdk:"ttl"`
+	Priority types.Int64  `tfsdk:"priority"`
+}
+
+// NewDNSRecordsResource returns a new instance of the DNS records resource.
+func NewDNSRecordsResource() resource.Resource {
+	return &DNSRecordsResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *DNSRecordsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_records"
+}
+
+// Schema defines the schema for the resource.
+func (r *DNSRecordsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Authoritatively manages the DNS records of a zone: records in the zone that are not listed, including records added outside Terraform, are removed. The SOA record and the NS records at the zone apex are managed by OpenProvider and left untouched. All changes are applied in a single API request per apply. Do not combine with `openprovider_dns_record` resources for the same zone.",
+		Attributes: map[string]schema.Attribute{
+			"zone_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the DNS zone containing the records (e.g., example.com). Changing this forces new records.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"records": schema.SetNestedAttribute{
+				MarkdownDescription: "Every DNS record of the zone, apart from the SOA and apex NS records. Records are identified by name, type, value and priority; each may be listed once.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the DNS record (e.g., www, mail, @ for root).",
+							Required:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The DNS record type (A, AAAA, CNAME, MX, TXT, NS, SRV, etc.).",
+							Required:            true,
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "The value of the DNS record (IP address, hostname, or text).",
+							Required:            true,
+						},
+						"ttl": schema.Int64Attribute{
+							MarkdownDescription: "The time-to-live (TTL) in seconds for the record. Default is 3600.",
+							Optional:            true,
+							Computed:            true,
+							Default:             int64default.StaticInt64(3600),
+						},
+						"priority": schema.Int64Attribute{
+							MarkdownDescription: "The priority for MX and SRV records. Lower values have higher priority.",
+							Optional:            true,
+							Computed:            true,
+							Default:             int64default.StaticInt64(0),
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier for the record set (the zone name).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"allow_deletion": schema.BoolAttribute{
+				MarkdownDescription: "Enable deletion of the DNS records. When false (default), the records are removed from Terraform state but preserved in OpenProvider. Set to true to delete every record of the zone apart from the SOA and apex NS records when the resource is destroyed.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *DNSRecordsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig rejects records that are listed more than once with different
+// TTLs. Such records are one record in the zone, so they would never match the
+// state.
+func (r *DNSRecordsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config DNSRecordsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := make(map[dns.RecordKey]bool, len(config.Records))
+	for _, entry := range config.Records {
+		if entry.Name.IsUnknown() || entry.Type.IsUnknown() || entry.Value.IsUnknown() || entry.Priority.IsUnknown() {
+			continue
+		}
+
+		key := recordFromModel(entry).Key()
+		if seen[key] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("records"),
+				"Duplicate DNS Record",
+				fmt.Sprintf("The %s record %s with value %q and priority %d is listed more than once. List each record once.",
+					key.Type, key.Name, key.Value, key.Priority),
+			)
+		}
+		seen[key] = true
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *DNSRecordsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan DNSRecordsModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneName := plan.ZoneName.ValueString()
+
+	// The zone may already hold records, such as those of a DNS template, which
+	// are replaced by the listed ones
+	current, err := ownedRecords(ctx, r.client, zoneName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating DNS records",
+			fmt.Sprintf("Could not read DNS records in zone %s: %s", zoneName, err.Error()),
+		)
+		return
+	}
+
+	updates := dns.DiffRecords(current, recordsFromModel(plan.Records))
+	if err := dns.UpdateRecords(ctx, r.client, zoneName, updates); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating DNS records",
+			fmt.Sprintf("Could not create DNS records in zone %s: %s", zoneName, err.Error()),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(zoneName)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *DNSRecordsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state DNSRecordsModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneName := state.ZoneName.ValueString()
+
+	records, err := ownedRecords(ctx, r.client, zoneName)
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading DNS records",
+			fmt.Sprintf("Could not read DNS records in zone %s: %s", zoneName, err.Error()),
+		)
+		return
+	}
+
+	// Every record in the zone is reported, so that records changed, added or
+	// removed outside Terraform show up in the next plan
+	state.Records = make([]DNSRecordsEntryModel, 0, len(records))
+	for _, record := range records {
+		state.Records = append(state.Records, recordToModel(record))
+	}
+	state.ID = types.StringValue(zoneName)
+	if state.AllowDeletion.IsNull() {
+		state.AllowDeletion = types.BoolValue(false)
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *DNSRecordsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan DNSRecordsModel
+	var state DNSRecordsModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneName := plan.ZoneName.ValueString()
+
+	// Diffing against the zone rather than the state also removes records added
+	// since the last refresh
+	current, err := ownedRecords(ctx, r.client, zoneName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating DNS records",
+			fmt.Sprintf("Could not read DNS records in zone %s: %s", zoneName, err.Error()),
+		)
+		return
+	}
+
+	updates := dns.DiffRecords(current, recordsFromModel(plan.Records))
+	if err := dns.UpdateRecords(ctx, r.client, zoneName, updates); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating DNS records",
+			fmt.Sprintf("Could not update DNS records in zone %s: %s", zoneName, err.Error()),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(zoneName)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource based on the allow_deletion flag.
+func (r *DNSRecordsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state DNSRecordsModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneName := state.ZoneName.ValueString()
+
+	// Check if deletion is allowed
+	allowDeletion := !state.AllowDeletion.IsNull() && state.AllowDeletion.ValueBool()
+
+	if !allowDeletion {
+		// Remove from state only - preserve the records in OpenProvider
+		resp.Diagnostics.AddWarning(
+			"DNS Records Removed from Terraform State Only",
+			fmt.Sprintf("%d DNS records in zone %s have been removed from your Terraform state but NOT deleted in OpenProvider. "+
+				"To enable deletion, set allow_deletion = true on the resource.",
+				len(state.Records), zoneName),
+		)
+		return
+	}
+
+	// Proceed with deletion since allow_deletion is true
+	updates := dns.DiffRecords(recordsFromModel(state.Records), nil)
+	if err := dns.UpdateRecords(ctx, r.client, zoneName, updates); err != nil {
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error deleting DNS records",
+			fmt.Sprintf("Could not delete DNS records in zone %s: %s", zoneName, err.Error()),
+		)
+		return
+	}
+}
+
+// ImportState imports the records of a zone by zone name.
+func (r *DNSRecordsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	zoneName := strings.TrimSuffix(strings.ToLower(req.ID), ".")
+	if _, err := domainname.Parse(zoneName); err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected a zone name such as example.com: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), zoneName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone_name"), zoneName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("allow_deletion"), false)...)
+}
+
+// ownedRecords lists the records of the zone that the resource owns: all but
+// the records OpenProvider manages itself (see zoneManagedRecord).
+func ownedRecords(ctx context.Context, c *client.Client, zoneName string) ([]dns.Record, error) {
+	records, err := dns.ListRecords(ctx, c, zoneName)
+	if err != nil {
+		return nil, err
+	}
+
+	owned := make([]dns.Record, 0, len(records))
+	for _, record := range records {
+		if !zoneManagedRecord(record, zoneName) {
+			owned = append(owned, record)
+		}
+	}
+	return owned, nil
+}
+
+// zoneManagedRecord reports whether OpenProvider manages record itself: the SOA
+// record and the NS records at the zone apex, which delegate the zone to
+// OpenProvider's nameservers.
+func zoneManagedRecord(record dns.Record, zoneName string) bool {
+	switch strings.ToUpper(record.Type) {
+	case "SOA":
+		return true
+	case "NS":
+		return record.Name == "" || record.Name == "@" || strings.EqualFold(record.Name, zoneName)
+	default:
+		return false
+	}
+}
+
+// recordToModel converts an API record to a record entry.
+func recordToModel(record dns.Record) DNSRecordsEntryModel {
+	return DNSRecordsEntryModel{
+		Name:     types.StringValue(record.Name),
+		Type:     types.StringValue(record.Type),
+		Value:    types.StringValue(record.Value),
+		TTL:      types.Int64Value(int64(record.TTL)),
+		Priority: types.Int64Value(int64(record.Priority)),
+	}
+}
+
+// recordFromModel converts a record entry to its API representation.
+func recordFromModel(entry DNSRecordsEntryModel) dns.Record {
+	return dns.Record{
+		Name:     entry.Name.ValueString(),
+		Type:     entry.Type.ValueString(),
+		Value:    entry.Value.ValueString(),
+		TTL:      int(entry.TTL.ValueInt64()),
+		Priority: int(entry.Priority.ValueInt64()),
+	}
+}
+
+// recordsFromModel converts record entries to their API representation.
+func recordsFromModel(entries []DNSRecordsEntryModel) []dns.Record {
+	records := make([]dns.Record, 0, len(entries))
+	for _, entry := range entries {
+		records = append(records, recordFromModel(entry))
+	}
+	return records
+}
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client/dns"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourcetest "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestDNSRecordsResourceMetadata(t *testing.T) {
+	r := NewDNSRecordsResource()
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "openprovider"}, resp)
+
+	if resp.TypeName != "openprovider_dns_records" {
+		t.Errorf("Expected TypeName openprovider_dns_records, got %s", resp.TypeName)
+	}
+}
+
+func TestAccDNSRecordsResource(t *testing.T) {
+	stub := newStubServer(t)
+	soa := dns.Record{Name: "@", Type: "SOA", Value: "ns1.openprovider.nl dns@openprovider.eu 2026101701 10800 3600 604800 3600", TTL: 86400}
+	ns := dns.Record{Name: "@", Type: "NS", Value: "ns1.openprovider.nl", TTL: 86400}
+	stub.addZone("example.com", soa, ns, dns.Record{Name: "old", Type: "A", Value: "192.0.2.9", TTL: 3600})
+
+	config := func(records string) string {
+		return stub.config(fmt.Sprintf(`
+resource "openprovider_dns_records" "test" {
+  zone_name      = "example.com"
+  allow_deletion = true
+
+  records = [%s
+  ]
+}
+`, records))
+	}
+	www1 := `
+    { name = "www", type = "A", value = "192.0.2.1" },`
+	www2 := `
+    { name = "www", type = "A", value = "192.0.2.2" },`
+	www3 := `
+    { name = "www", type = "A", value = "192.0.2.3" },`
+	mx := `
+    { name = "@", type = "MX", value = "mail.example.com", priority = 10, ttl = %d },`
+
+	// zoneHas checks the records of the zone, which always keeps its SOA and NS records
+	zoneHas := func(values ...string) resourcetest.TestCheckFunc {
+		return func(*terraform.State) error {
+			records := stub.zoneRecords("example.com")
+			if len(records) != len(values)+2 {
+				return fmt.Errorf("expected %d records, got %+v", len(values)+2, records)
+			}
+			for _, value := range append(values, soa.Value, ns.Value) {
+				found := false
+				for _, record := range records {
+					found = found || record.Value == value
+				}
+				if !found {
+					return fmt.Errorf("expected a record with value %s, got %+v", value, records)
+				}
+			}
+			return nil
+		}
+	}
+
+	resourcetest.Test(t, resourcetest.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resourcetest.TestStep{
+			{
+				Config:      config(www1 + `{ name = "www", type = "A", value = "192.0.2.1", ttl = 60 },`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Duplicate DNS Record"),
+			},
+			{
+				// The record added outside Terraform is removed
+				Config: config(www1 + www2 + fmt.Sprintf(mx, 3600)),
+				Check: resourcetest.ComposeAggregateTestCheckFunc(
+					resourcetest.TestCheckResourceAttr("openprovider_dns_records.test", "id", "example.com"),
+					resourcetest.TestCheckResourceAttr("openprovider_dns_records.test", "records.#", "3"),
+					zoneHas("192.0.2.1", "192.0.2.2", "mail.example.com"),
+				),
+			},
+			refreshWithoutChanges,
+			{
+				// Values are replaced and TTLs changed in a single request
+				Config: config(www1 + www3 + fmt.Sprintf(mx, 300)),
+				Check: resourcetest.ComposeAggregateTestCheckFunc(
+					resourcetest.TestCheckResourceAttr("openprovider_dns_records.test", "records.#", "3"),
+					zoneHas("192.0.2.1", "192.0.2.3", "mail.example.com"),
+				),
+			},
+			{
+				// A record added outside Terraform shows up as drift
+				PreConfig: func() {
+					stub.setRecords("example.com", append(stub.zoneRecords("example.com"),
+						dns.Record{Name: "test", Type: "TXT", Value: "added by hand", TTL: 300})...)
+				},
+				Config:             config(www1 + www3 + fmt.Sprintf(mx, 300)),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config(www1 + www3 + fmt.Sprintf(mx, 300)),
+				Check:  zoneHas("192.0.2.1", "192.0.2.3", "mail.example.com"),
+			},
+			{
+				ResourceName:      "openprovider_dns_records.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// A safeguard that defaults to false on import
+				ImportStateVerifyIgnore: []string{"allow_deletion"},
+			},
+		},
+		CheckDestroy: func(*terraform.State) error {
+			return zoneHas()(nil)
+		},
+	})
+
+	if got := stub.countRequests("PUT /v1beta/dns/zones/example.com"); got != 4 {
+		t.Errorf("Expected one update per apply and destroy, got %d", got)
+	}
+}
@@ -0,0 +1,461 @@
+// Package provider implements the Terraform provider for OpenProvider.
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client"
+	"github.com/charpand/terraform-provider-openprovider/internal/client/dns"
+	"github.com/charpand/terraform-provider-openprovider/internal/domainname"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &DNSZoneResource{}
+	_ resource.ResourceWithConfigure      = &DNSZoneResource{}
+	_ resource.ResourceWithImportState    = &DNSZoneResource{}
+	_ resource.ResourceWithValidateConfig = &DNSZoneResource{}
+)
+
+// DNSZoneResource is the resource implementation.
+type DNSZoneResource struct {
+	client *client.Client
+}
+
+// DNSZoneModel describes the resource data model.
+type DNSZoneModel struct {
+	ZoneName         types.String `tfsdk:"zone_name"`
+	Name             types.String `tfsdk:"name"`
+	Extension        types.String `tfsdk:"extension"`
+	Type             types.String `tfsdk:"type"`
+	MasterIP         types.String `tfsdk:"master_ip"`
+	TemplateName     types.String `tfsdk:"template_name"`
+	PremiumDNS       types.Bool   `tfsdk:"premium_dns"`
+	DNSSEC           types.Bool   `tfsdk:"dnssec"`
+	CreationDate     types.String `tfsdk:"creation_date"`
+	ModificationDate types.String `tfsdk:"modification_date"`
+	ID               types.String `tfsdk:"id"`
+	AllowDeletion    types.Bool   `tfsdk:"allow_deletion"`
+}
+
+// NewDNSZoneResource returns a new instance of the DNS zone resource.
+func NewDNSZoneResource() resource.Resource {
+	return &DNSZoneResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *DNSZoneResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_zone"
+}
+
+// Schema defines the schema for the resource.
+func (r *DNSZoneResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a DNS zone. Records in the zone can be managed with `openprovider_dns_record`.",
+		Attributes: map[string]schema.Attribute{
+			"zone_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the DNS zone (e.g., example.com, example.co.uk or münchen.de). Changing this forces a new zone.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name part of the zone (e.g., 'example' in 'example.com').",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"extension": schema.StringAttribute{
+				MarkdownDescription: "The extension/TLD of the zone (e.g., 'com' in 'example.com').",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of DNS zone: `master` (served by OpenProvider) or `slave` (transferred from `master_ip`). Default is `master`. Changing this forces a new zone.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(dns.ZoneTypeMaster),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"master_ip": schema.StringAttribute{
+				MarkdownDescription: "The IP address of the master nameserver. Required for `slave` zones, and only refreshed from OpenProvider for them.",
+				Optional:            true,
+			},
+			"template_name": schema.StringAttribute{
+				MarkdownDescription: "The name of a DNS template whose records are added to the zone on creation. Changing this forces a new zone.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"premium_dns": schema.BoolAttribute{
+				MarkdownDescription: "Serve the zone from OpenProvider's premium DNS (Sectigo) network. Default is false.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"dnssec": schema.BoolAttribute{
+				MarkdownDescription: "Sign the zone with DNSSEC. Default is false.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"creation_date": schema.StringAttribute{
+				MarkdownDescription: "The date and time when the zone was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"modification_date": schema.StringAttribute{
+				MarkdownDescription: "The date and time when the zone was last modified.",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The zone identifier (the zone name).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"allow_deletion": schema.BoolAttribute{
+				MarkdownDescription: "Enable deletion of this DNS zone and all of its records. When false (default), the zone is removed from Terraform state but preserved in OpenProvider. Set to true to permit actual deletion.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *DNSZoneResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig checks the zone type and its master IP.
+func (r *DNSZoneResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config DNSZoneModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.ZoneName.IsNull() && !config.ZoneName.IsUnknown() {
+		if _, err := domainname.Parse(config.ZoneName.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("zone_name"), "Invalid Zone Name", err.Error())
+		}
+	}
+
+	if config.Type.IsNull() || config.Type.IsUnknown() {
+		return
+	}
+
+	switch config.Type.ValueString() {
+	case dns.ZoneTypeMaster:
+	case dns.ZoneTypeSlave:
+		if config.MasterIP.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("master_ip"),
+				"Missing Master IP",
+				"master_ip is required when type is \"slave\".",
+			)
+		}
+	default:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("type"),
+			"Invalid Zone Type",
+			fmt.Sprintf("type must be %q or %q, got %q.", dns.ZoneTypeMaster, dns.ZoneTypeSlave, config.Type.ValueString()),
+		)
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *DNSZoneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan DNSZoneModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneName := plan.ZoneName.ValueString()
+	parsed, err := domainname.Parse(zoneName)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Zone Name", err.Error())
+		return
+	}
+
+	createReq := &dns.CreateZoneRequest{
+		Domain: dns.ZoneDomain{
+			Name:      parsed.Label,
+			Extension: parsed.Extension,
+		},
+		Type:     plan.Type.ValueString(),
+		MasterIP: plan.MasterIP.ValueString(),
+		Provider: zoneProvider(plan.PremiumDNS.ValueBool()),
+		Secured:  plan.DNSSEC.ValueBool(),
+	}
+	if !plan.TemplateName.IsNull() {
+		createReq.TemplateName = plan.TemplateName.ValueString()
+	}
+
+	if err := dns.CreateZone(ctx, r.client, createReq); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating DNS zone",
+			fmt.Sprintf("Could not create DNS zone %s: %s", zoneName, err.Error()),
+		)
+		return
+	}
+
+	zone, err := dns.GetZone(ctx, r.client, parsed.String())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading DNS zone",
+			fmt.Sprintf("DNS zone %s was created but could not be read: %s", zoneName, err.Error()),
+		)
+		return
+	}
+
+	mapZoneToState(zone, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *DNSZoneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state DNSZoneModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneName := state.ZoneName.ValueString()
+
+	zone, err := dns.GetZone(ctx, r.client, zoneAPIName(zoneName))
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading DNS zone",
+			fmt.Sprintf("Could not read DNS zone %s: %s", zoneName, err.Error()),
+		)
+		return
+	}
+
+	mapZoneToState(zone, &state)
+	refreshZoneSettings(zone, &state)
+
+	// Imported zones have no configuration-only values yet.
+	if state.AllowDeletion.IsNull() {
+		state.AllowDeletion = types.BoolValue(false)
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *DNSZoneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan DNSZoneModel
+	var state DNSZoneModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneName := state.ZoneName.ValueString()
+
+	updateReq := &dns.UpdateZoneRequest{}
+	changed := false
+
+	if !plan.MasterIP.Equal(state.MasterIP) {
+		masterIP := plan.MasterIP.ValueString()
+		updateReq.MasterIP = &masterIP
+		changed = true
+	}
+	if !plan.PremiumDNS.Equal(state.PremiumDNS) {
+		provider := zoneProvider(plan.PremiumDNS.ValueBool())
+		updateReq.Provider = &provider
+		changed = true
+	}
+	if !plan.DNSSEC.Equal(state.DNSSEC) {
+		secured := plan.DNSSEC.ValueBool()
+		updateReq.Secured = &secured
+		changed = true
+	}
+
+	if changed {
+		if err := dns.UpdateZone(ctx, r.client, zoneAPIName(zoneName), updateReq); err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating DNS zone",
+				fmt.Sprintf("Could not update DNS zone %s: %s", zoneName, err.Error()),
+			)
+			return
+		}
+	}
+
+	zone, err := dns.GetZone(ctx, r.client, zoneAPIName(zoneName))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading DNS zone",
+			fmt.Sprintf("Could not read DNS zone %s after update: %s", zoneName, err.Error()),
+		)
+		return
+	}
+
+	mapZoneToState(zone, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource based on the allow_deletion flag.
+func (r *DNSZoneResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state DNSZoneModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneName := state.ZoneName.ValueString()
+
+	// Check if deletion is allowed
+	allowDeletion := !state.AllowDeletion.IsNull() && state.AllowDeletion.ValueBool()
+
+	if !allowDeletion {
+		// Remove from state only - preserve the zone in OpenProvider
+		resp.Diagnostics.AddWarning(
+			"DNS Zone Removed from Terraform State Only",
+			fmt.Sprintf("DNS zone %s has been removed from your Terraform state but NOT deleted in OpenProvider. "+
+				"The zone and its records still exist and can be reimported. "+
+				"To enable deletion, set allow_deletion = true on the resource.",
+				zoneName),
+		)
+		return
+	}
+
+	// Proceed with deletion since allow_deletion is true
+	if err := dns.DeleteZone(ctx, r.client, zoneAPIName(zoneName)); err != nil {
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error deleting DNS zone",
+			fmt.Sprintf("Could not delete DNS zone %s: %s", zoneName, err.Error()),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing resource into Terraform.
+func (r *DNSZoneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The import ID is the zone name
+	zoneName := strings.TrimSuffix(strings.ToLower(req.ID), ".")
+	if _, err := domainname.Parse(zoneName); err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected a zone name such as example.com: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), zoneName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone_name"), zoneName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("allow_deletion"), false)...)
+}
+
+// refreshZoneSettings refreshes the configurable settings of a zone from the
+// API. It is only called on Read: created and updated zones keep their planned
+// settings, so that the result of an apply always matches its plan, and any
+// difference shows up on the next refresh.
+func refreshZoneSettings(zone *dns.Zone, model *DNSZoneModel) {
+	if zone.Type != "" {
+		model.Type = types.StringValue(zone.Type)
+	}
+	model.PremiumDNS = types.BoolValue(zone.Provider == dns.ZoneProviderPremium)
+	model.DNSSEC = types.BoolValue(zone.Secured)
+	refreshMasterIP(zone, model)
+}
+
+// refreshMasterIP refreshes master_ip of a slave zone from the API. A value in
+// state that denotes the same address is kept in its configured form. Master
+// zones keep master_ip as configured, even when the API reports an address for
+// them.
+func refreshMasterIP(zone *dns.Zone, model *DNSZoneModel) {
+	if zone.Type != dns.ZoneTypeSlave || zone.IP == "" {
+		return
+	}
+
+	current := net.ParseIP(model.MasterIP.ValueString())
+	if model.MasterIP.ValueString() == zone.IP || (current != nil && current.Equal(net.ParseIP(zone.IP))) {
+		return
+	}
+	model.MasterIP = types.StringValue(zone.IP)
+}
+
+// mapZoneToState copies the attributes OpenProvider assigns to a zone onto the
+// model. Configurable settings are left to refreshZoneSettings.
+func mapZoneToState(zone *dns.Zone, model *DNSZoneModel) {
+	zoneName := fmt.Sprintf("%s.%s", zone.Name, zone.Extension)
+
+	model.ID = types.StringValue(zoneName)
+	model.Name = types.StringValue(zone.Name)
+	model.Extension = types.StringValue(zone.Extension)
+	model.CreationDate = types.StringValue(zone.CreationDate)
+	model.ModificationDate = types.StringValue(zone.ModificationDate)
+}
+
+// zoneProvider returns the DNS provider to request for a zone.
+func zoneProvider(premium bool) string {
+	if premium {
+		return dns.ZoneProviderPremium
+	}
+	return dns.ZoneProviderStandard
+}
+
+// zoneAPIName returns the ASCII form of a zone name, as used in API paths. Zone
+// names are validated during planning, so an invalid name is returned unchanged.
+func zoneAPIName(zoneName string) string {
+	ascii, err := domainname.ToASCII(zoneName)
+	if err != nil {
+		return zoneName
+	}
+	return ascii
+}
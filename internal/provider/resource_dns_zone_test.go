@@ -0,0 +1,203 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client/dns"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourcetest "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestDNSZoneResourceMetadata(t *testing.T) {
+	r := NewDNSZoneResource()
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "openprovider"}, resp)
+
+	if resp.TypeName != "openprovider_dns_zone" {
+		t.Errorf("Expected TypeName openprovider_dns_zone, got %s", resp.TypeName)
+	}
+}
+
+func TestDNSZoneResourceSchema(t *testing.T) {
+	r := NewDNSZoneResource()
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	expectedAttrs := []string{
+		"id", "zone_name", "name", "extension", "type", "master_ip", "template_name",
+		"premium_dns", "dnssec", "creation_date", "modification_date", "allow_deletion",
+	}
+	for _, attr := range expectedAttrs {
+		if _, ok := resp.Schema.Attributes[attr]; !ok {
+			t.Errorf("Expected attribute %s not found in schema", attr)
+		}
+	}
+}
+
+func TestAccDNSZoneResource(t *testing.T) {
+	stub := newStubServer(t)
+	config := func(premiumDNS bool) string {
+		return stub.config(fmt.Sprintf(`
+resource "openprovider_dns_zone" "test" {
+  zone_name      = "example.com"
+  template_name  = "default"
+  dnssec         = true
+  premium_dns    = %t
+  allow_deletion = true
+}
+`, premiumDNS))
+	}
+
+	resourcetest.Test(t, resourcetest.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resourcetest.TestStep{
+			{
+				Config: config(false),
+				Check: resourcetest.ComposeAggregateTestCheckFunc(
+					resourcetest.TestCheckResourceAttr("openprovider_dns_zone.test", "id", "example.com"),
+					resourcetest.TestCheckResourceAttr("openprovider_dns_zone.test", "extension", "com"),
+					resourcetest.TestCheckResourceAttr("openprovider_dns_zone.test", "dnssec", "true"),
+				),
+			},
+			refreshWithoutChanges,
+			{
+				Config: config(true),
+				Check: func(*terraform.State) error {
+					if zone, _ := stub.zone("example.com"); zone.Provider != dns.ZoneProviderPremium || !zone.Secured {
+						return fmt.Errorf("expected premium DNS and DNSSEC to be enabled, got %+v", zone)
+					}
+					return nil
+				},
+			},
+			{
+				ResourceName:      "openprovider_dns_zone.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Only used on creation, or a safeguard that defaults to false on import
+				ImportStateVerifyIgnore: []string{"template_name", "allow_deletion"},
+			},
+		},
+		CheckDestroy: func(*terraform.State) error {
+			if _, exists := stub.zone("example.com"); exists {
+				return fmt.Errorf("expected the zone to be deleted")
+			}
+			return nil
+		},
+	})
+}
+
+func TestAccDNSZoneResourceValidation(t *testing.T) {
+	stub := newStubServer(t)
+	config := func(zoneName, attributes string) string {
+		return stub.config(fmt.Sprintf(`
+resource "openprovider_dns_zone" "test" {
+  zone_name = %q
+  %s
+}
+`, zoneName, attributes))
+	}
+
+	resourcetest.Test(t, resourcetest.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resourcetest.TestStep{
+			{
+				Config:      config("example.com", `type = "slave"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Missing Master IP"),
+			},
+			{
+				Config:      config("example.com", `type = "native"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Zone Type"),
+			},
+			{
+				Config:      config("www.example.com", ""),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Zone Name"),
+			},
+		},
+	})
+}
+
+func TestAccDNSZoneResourceRefresh(t *testing.T) {
+	stub := newStubServer(t)
+	config := stub.config(`
+resource "openprovider_dns_zone" "master" {
+  zone_name = "example.co.uk"
+}
+
+resource "openprovider_dns_zone" "slave" {
+  zone_name = "münchen.de"
+  type      = "slave"
+  master_ip = "2001:db8::1"
+}
+`)
+
+	resourcetest.Test(t, resourcetest.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resourcetest.TestStep{
+			{
+				Config: config,
+				Check: resourcetest.ComposeAggregateTestCheckFunc(
+					resourcetest.TestCheckResourceAttr("openprovider_dns_zone.master", "extension", "co.uk"),
+					resourcetest.TestCheckResourceAttr("openprovider_dns_zone.slave", "name", "xn--mnchen-3ya"),
+				),
+			},
+			{
+				// A master zone ignores the address OpenProvider reports, and a slave
+				// zone keeps the configured form of its master's address
+				PreConfig: func() {
+					stub.updateZone("example.co.uk", func(zone *dns.Zone) { zone.IP = "198.51.100.1" })
+					stub.updateZone("xn--mnchen-3ya.de", func(zone *dns.Zone) { zone.IP = "2001:0db8:0000:0000:0000:0000:0000:0001" })
+				},
+				RefreshState:      true,
+				RefreshPlanChecks: refreshWithoutChanges.RefreshPlanChecks,
+			},
+			{
+				// A master changed outside Terraform shows up as drift
+				PreConfig: func() {
+					stub.updateZone("xn--mnchen-3ya.de", func(zone *dns.Zone) { zone.IP = "2001:db8::2" })
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+		// Without allow_deletion the zones are kept
+		CheckDestroy: func(*terraform.State) error {
+			for _, name := range []string{"example.co.uk", "xn--mnchen-3ya.de"} {
+				if _, exists := stub.zone(name); !exists {
+					return fmt.Errorf("expected zone %s to be kept", name)
+				}
+			}
+			return nil
+		},
+	})
+}
+
+func TestAccDNSZoneResourceKeepsPlannedSettings(t *testing.T) {
+	stub := newStubServer(t)
+	stub.holdSettings()
+
+	resourcetest.Test(t, resourcetest.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resourcetest.TestStep{
+			{
+				// Settings OpenProvider has not applied yet are kept as planned, and
+				// the next refresh reports what it actually serves
+				Config: stub.config(`
+resource "openprovider_dns_zone" "test" {
+  zone_name   = "example.com"
+  premium_dns = true
+}
+`),
+				Check:              resourcetest.TestCheckResourceAttr("openprovider_dns_zone.test", "premium_dns", "true"),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
@@ -3,11 +3,17 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"strings"
+	"net"
+	"time"
 
 	"github.com/charpand/terraform-provider-openprovider/internal/client"
 	"github.com/charpand/terraform-provider-openprovider/internal/client/domains"
+	"github.com/charpand/terraform-provider-openprovider/internal/client/tlds"
+	"github.com/charpand/terraform-provider-openprovider/internal/domainname"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -16,16 +22,43 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &DomainResource{}
-	_ resource.ResourceWithConfigure   = &DomainResource{}
-	_ resource.ResourceWithImportState = &DomainResource{}
+	_ resource.Resource                     = &DomainResource{}
+	_ resource.ResourceWithConfigure        = &DomainResource{}
+	_ resource.ResourceWithImportState      = &DomainResource{}
+	_ resource.ResourceWithModifyPlan       = &DomainResource{}
+	_ resource.ResourceWithValidateConfig   = &DomainResource{}
+	_ resource.ResourceWithConfigValidators = &DomainResource{}
+)
+
+// Deletion modes of the domain resource, applied when allow_deletion is true.
+const (
+	// domainDeletionStateOnly -- remove the domain from state and leave it registered
+	domainDeletionStateOnly = "state_only"
+	// domainDeletionDelete -- delete the domain at the registry
+	domainDeletionDelete = "delete"
+	// domainDeletionAtExpiry -- turn autorenew off and let the domain lapse
+	domainDeletionAtExpiry = "delete_at_expiry"
+)
+
+// defaultWaitTimeout is how long wait_for_transfer and owner changes wait when no
+// create or update timeout is configured.
+const defaultWaitTimeout = 60 * time.Minute
+
+// Bounds on the number of nameservers of a domain.
+const (
+	minNameservers = 2
+	maxNameservers = 13
 )
 
 // dnssecKeysAttrTypes defines the attribute types for DNSSEC keys.
@@ -37,9 +70,53 @@ var dnssecKeysAttrTypes = map[string]attr.Type{
 	"public_key": types.StringType,
 }
 
+// nameserverAttrTypes defines the attribute types for nameservers.
+var nameserverAttrTypes = map[string]attr.Type{
+	"name":   types.StringType,
+	"ip":     types.StringType,
+	"ip6":    types.StringType,
+	"seq_nr": types.Int64Type,
+}
+
+// premiumPriceAttrTypes defines the attribute types for premium prices.
+var premiumPriceAttrTypes = map[string]attr.Type{
+	"amount":   types.Float64Type,
+	"currency": types.StringType,
+}
+
+// convertAdditionalDataToAPI converts additional data from Terraform state to API
+// format. Unknown values are left empty.
+func convertAdditionalDataToAPI(ctx context.Context, obj types.Object, diags *diag.Diagnostics) *domains.AdditionalData {
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil
+	}
+
+	var data AdditionalDataModel
+	diags.Append(obj.As(ctx, &data, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil
+	}
+
+	return &domains.AdditionalData{
+		ApplicationPurpose:   data.ApplicationPurpose.ValueString(),
+		CountryOfCitizenship: data.CountryOfCitizenship.ValueString(),
+		EntityType:           data.EntityType.ValueString(),
+		IDNumber:             data.IDNumber.ValueString(),
+		IDType:               data.IDType.ValueString(),
+		LegalType:            data.LegalType.ValueString(),
+		NexusCategory:        data.NexusCategory.ValueString(),
+		VAT:                  data.VAT.ValueString(),
+	}
+}
+
 // DomainResource is the resource implementation.
 type DomainResource struct {
 	client *client.Client
+
+	// waitInterval is the time between status checks of wait_for_transfer and
+	// owner changes.
+	// Zero means domains.DefaultWaitInterval.
+	waitInterval time.Duration
 }
 
 // convertDnssecKeysToAPI converts DNSSEC keys from Terraform state to API format.
@@ -90,6 +167,73 @@ func mapDnssecKeysToState(ctx context.Context, keys []domains.DnssecKey, diags *
 	return listValue
 }
 
+// convertNameserversToAPI converts nameservers from Terraform state to API format.
+func convertNameserversToAPI(ctx context.Context, set types.Set, diags *diag.Diagnostics) []domains.Nameserver {
+	if set.IsNull() || set.IsUnknown() || len(set.Elements()) == 0 {
+		return nil
+	}
+
+	var nameservers []NameserverModel
+	diags.Append(set.ElementsAs(ctx, &nameservers, false)...)
+	if diags.HasError() {
+		return nil
+	}
+
+	apiNameservers := make([]domains.Nameserver, 0, len(nameservers))
+	for _, ns := range nameservers {
+		apiNameservers = append(apiNameservers, domains.Nameserver{
+			Name:  ns.Name.ValueString(),
+			IP:    ns.IP.ValueString(),
+			IP6:   ns.IP6.ValueString(),
+			SeqNr: int(ns.SeqNr.ValueInt64()),
+		})
+	}
+	return apiNameservers
+}
+
+// mapNameserversToState converts nameservers from API format to Terraform state.
+// The API numbers every nameserver, so seq_nr is only kept for nameservers that
+// had one in prior; otherwise the numbering would show up as a diff.
+func mapNameserversToState(ctx context.Context, nameservers []domains.Nameserver, prior types.Set, diags *diag.Diagnostics) types.Set {
+	elemType := types.ObjectType{AttrTypes: nameserverAttrTypes}
+	if len(nameservers) == 0 {
+		return types.SetNull(elemType)
+	}
+
+	numbered := make(map[string]bool)
+	if !prior.IsNull() && !prior.IsUnknown() {
+		var priorNameservers []NameserverModel
+		diags.Append(prior.ElementsAs(ctx, &priorNameservers, false)...)
+		for _, ns := range priorNameservers {
+			numbered[ns.Name.ValueString()] = !ns.SeqNr.IsNull()
+		}
+	}
+
+	stateNameservers := make([]NameserverModel, 0, len(nameservers))
+	for _, ns := range nameservers {
+		model := NameserverModel{
+			Name:  types.StringValue(ns.Name),
+			IP:    types.StringNull(),
+			IP6:   types.StringNull(),
+			SeqNr: types.Int64Null(),
+		}
+		if ns.IP != "" {
+			model.IP = types.StringValue(ns.IP)
+		}
+		if ns.IP6 != "" {
+			model.IP6 = types.StringValue(ns.IP6)
+		}
+		if numbered[ns.Name] {
+			model.SeqNr = types.Int64Value(int64(ns.SeqNr))
+		}
+		stateNameservers = append(stateNameservers, model)
+	}
+
+	setValue, setDiags := types.SetValueFrom(ctx, elemType, stateNameservers)
+	diags.Append(setDiags...)
+	return setValue
+}
+
 // NewDomainResource returns a new instance of the domain resource.
 func NewDomainResource() resource.Resource {
 	return &DomainResource{}
@@ -101,7 +245,7 @@ func (r *DomainResource) Metadata(_ context.Context, req resource.MetadataReques
 }
 
 // Schema defines the schema for the resource.
-func (r *DomainResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *DomainResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages an OpenProvider domain. Supports both domain registration and domain transfer. To transfer a domain, provide an auth_code.",
 		Attributes: map[string]schema.Attribute{
@@ -138,9 +282,17 @@ func (r *DomainResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Default:             booldefault.StaticBool(false),
 			},
 			"owner_handle": schema.StringAttribute{
-				MarkdownDescription: "The owner contact handle for the domain.",
+				MarkdownDescription: "The owner (registrant) contact handle for the domain. Changing it requests an owner change: a trade for extensions whose registries require one, such as .nl, .eu and .be (billable), and an update otherwise, which for generic extensions such as .com needs `accept_owner_change_lock`. The apply waits until the change completes, bounded by the `update` timeout.",
 				Required:            true,
 			},
+			"accept_owner_change_lock": schema.BoolAttribute{
+				MarkdownDescription: "Acknowledge that changing `owner_handle` of a domain under a generic extension (e.g., .com or .shop) locks it against transfers to another registrar for 60 days, as the ICANN Transfer Policy requires. Owner changes of such domains fail at plan time unless this is `true`.",
+				Optional:            true,
+			},
+			"pending_owner_handle": schema.StringAttribute{
+				MarkdownDescription: "The owner handle of an owner change that was requested but has not completed yet, for instance because the registrants have not confirmed it. Applying the same `owner_handle` again waits for the pending change instead of requesting it again. Null when no change is pending.",
+				Computed:            true,
+			},
 			"admin_handle": schema.StringAttribute{
 				MarkdownDescription: "The admin contact handle for the domain.",
 				Optional:            true,
@@ -162,9 +314,33 @@ func (r *DomainResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Computed:            true,
 			},
 			"ns_group": schema.StringAttribute{
-				MarkdownDescription: "The nameserver group to use for this domain. Use this instead of nameserver blocks.",
+				MarkdownDescription: "The nameserver group to use for this domain. Conflicts with `nameservers`.",
 				Optional:            true,
 			},
+			"nameservers": schema.SetNestedAttribute{
+				MarkdownDescription: "The nameservers of the domain, for domains whose DNS is hosted elsewhere (e.g., Route 53 or Cloudflare). Between 2 and 13 nameservers; their order does not matter. Conflicts with `ns_group`.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The hostname of the nameserver (e.g., ns1.example.net).",
+							Required:            true,
+						},
+						"ip": schema.StringAttribute{
+							MarkdownDescription: "The IPv4 glue address of the nameserver. Only needed when the nameserver lies within the domain itself.",
+							Optional:            true,
+						},
+						"ip6": schema.StringAttribute{
+							MarkdownDescription: "The IPv6 glue address of the nameserver. Only needed when the nameserver lies within the domain itself.",
+							Optional:            true,
+						},
+						"seq_nr": schema.Int64Attribute{
+							MarkdownDescription: "The position of the nameserver in the delegation. When omitted, OpenProvider numbers the nameservers itself.",
+							Optional:            true,
+						},
+					},
+				},
+			},
 			"dnssec_keys": schema.ListNestedAttribute{
 				MarkdownDescription: "DNSSEC keys for the domain. Optional.",
 				Optional:            true,
@@ -201,10 +377,119 @@ func (r *DomainResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 					boolplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"is_locked": schema.BoolAttribute{
+				MarkdownDescription: "Whether the domain is locked against transfers at the registry (transfer lock). Set it to `false` before transferring the domain to another registrar. When not set, the current lock is left as it is.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_private_whois_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable WHOIS privacy protection, which replaces the owner's contact details in the public WHOIS with those of a privacy service. Enabling it fails at plan time for extensions that do not allow it according to the OpenProvider TLD catalogue. When not set, the current setting is left as it is.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"additional_data": schema.SingleNestedAttribute{
+				MarkdownDescription: "Registrant data that some registries require to register a domain, such as the .us nexus category or the .ca legal type. Values are checked at plan time, and the fields required for the domain's extension when planning a registration. This data is sent to OpenProvider but not read back.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"application_purpose": schema.StringAttribute{
+						MarkdownDescription: "The intended use of a .us domain: `P1` (business for profit), `P2` (non-profit), `P3` (personal), `P4` (educational) or `P5` (government).",
+						Optional:            true,
+					},
+					"country_of_citizenship": schema.StringAttribute{
+						MarkdownDescription: "For .eu domains of registrants residing outside the EU, the EU country of citizenship as an ISO 3166-1 alpha-2 code.",
+						Optional:            true,
+					},
+					"entity_type": schema.StringAttribute{
+						MarkdownDescription: "The registrant's entity type for .it domains: `1` (natural person), `2` (company), `3` (freelancer), `4` (non-profit), `5` (public body), `6` (other) or `7` (foreign entity).",
+						Optional:            true,
+					},
+					"id_number": schema.StringAttribute{
+						MarkdownDescription: "The registrant's identification number: the identity document number for .es, the tax code (codice fiscale) or VAT number for .it, and the personal numeric code (CNP) or company registration number for .ro.",
+						Optional:            true,
+					},
+					"id_type": schema.StringAttribute{
+						MarkdownDescription: "The type of the registrant's identity document for .es domains: `dni`, `nie`, `nif`, `passport` or `other`.",
+						Optional:            true,
+					},
+					"legal_type": schema.StringAttribute{
+						MarkdownDescription: "The registrant's Canadian presence category for .ca domains, e.g. `CCO` (corporation), `CCT` (citizen) or `RES` (permanent resident).",
+						Optional:            true,
+					},
+					"nexus_category": schema.StringAttribute{
+						MarkdownDescription: "The registrant's nexus with the United States for .us domains: `C11`, `C12`, `C21`, `C31` or `C32`.",
+						Optional:            true,
+					},
+					"vat": schema.StringAttribute{
+						MarkdownDescription: "The registrant's VAT number, e.g. for VAT-registered .ro registrants.",
+						Optional:            true,
+					},
+				},
+			},
+			"accept_premium_price": schema.SingleNestedAttribute{
+				MarkdownDescription: "The highest premium price accepted for registering the domain. Registering a domain that its registry sells at a premium price is refused unless this covers `premium_price`: the same currency and at least the same amount.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"amount": schema.Float64Attribute{
+						MarkdownDescription: "The accepted amount.",
+						Required:            true,
+					},
+					"currency": schema.StringAttribute{
+						MarkdownDescription: "The currency of `amount`, which must be the currency of the reseller account (e.g., `EUR`).",
+						Required:            true,
+					},
+				},
+			},
+			"premium_price": schema.SingleNestedAttribute{
+				MarkdownDescription: "The premium price charged to the reseller account for registering the domain, as quoted by OpenProvider when planning the registration. Null for domains without a premium price and for transfers.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"amount": schema.Float64Attribute{
+						MarkdownDescription: "The premium price.",
+						Computed:            true,
+					},
+					"currency": schema.StringAttribute{
+						MarkdownDescription: "The currency of `amount`.",
+						Computed:            true,
+					},
+				},
+			},
 			"expiration_date": schema.StringAttribute{
 				MarkdownDescription: "The domain expiration date.",
 				Computed:            true,
 			},
+			"allow_deletion": schema.BoolAttribute{
+				MarkdownDescription: "Enable deletion of this domain according to `deletion_mode`. When false (default), destroying the resource only removes it from Terraform state and the domain stays registered in OpenProvider.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"deletion_mode": schema.StringAttribute{
+				MarkdownDescription: "What destroying the resource does when `allow_deletion` is true: `state_only` (default) removes it from Terraform state only, `delete` deletes the domain at the registry, and `delete_at_expiry` turns autorenew off so that the domain lapses on its expiration date. Deleted domains can usually be restored from quarantine for a fee.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(domainDeletionStateOnly),
+			},
+			"wait_for_transfer": schema.BoolAttribute{
+				MarkdownDescription: "Wait for a transfer to complete (status `ACT`) before the resource is created, so that dependent resources only run once OpenProvider controls the domain. The wait is bounded by the `create` timeout, or the `update` timeout when enabled later. Default is `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
 		},
 	}
 }
@@ -227,6 +512,320 @@ func (r *DomainResource) Configure(_ context.Context, req resource.ConfigureRequ
 	r.client = client
 }
 
+// ConfigValidators returns the validators that check combinations of attributes.
+func (r *DomainResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(path.MatchRoot("nameservers"), path.MatchRoot("ns_group")),
+	}
+}
+
+// ValidateConfig checks the domain name, the additional data required by the
+// registry, the nameservers and the deletion mode.
+func (r *DomainResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config DomainModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Domain.IsNull() && !config.Domain.IsUnknown() {
+		parsed, err := domainname.Parse(config.Domain.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("domain"), "Invalid Domain Name", err.Error())
+		} else {
+			validateAdditionalData(ctx, config, parsed.Extension, false, &resp.Diagnostics)
+		}
+	}
+
+	validateNameservers(ctx, config, &resp.Diagnostics)
+
+	if config.DeletionMode.IsNull() || config.DeletionMode.IsUnknown() {
+		return
+	}
+
+	switch mode := config.DeletionMode.ValueString(); mode {
+	case domainDeletionStateOnly:
+	case domainDeletionDelete, domainDeletionAtExpiry:
+		if !config.AllowDeletion.IsUnknown() && !config.AllowDeletion.ValueBool() {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("deletion_mode"),
+				"Deletion Mode Has No Effect",
+				fmt.Sprintf("deletion_mode %q only applies when allow_deletion = true. Until then, destroying the domain only removes it from Terraform state.", mode),
+			)
+		}
+	default:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("deletion_mode"),
+			"Invalid Deletion Mode",
+			fmt.Sprintf("deletion_mode must be %q, %q or %q, got %q.", domainDeletionStateOnly, domainDeletionDelete, domainDeletionAtExpiry, mode),
+		)
+	}
+}
+
+// validateAdditionalData checks the additional data of a registration against
+// the requirements of the registry of extension. With missing set, only required
+// fields that are not set are reported; otherwise only set fields with invalid
+// values. Transfers are not checked, since the registry already holds the
+// registrant's data.
+func validateAdditionalData(ctx context.Context, config DomainModel, extension string, missing bool, diags *diag.Diagnostics) {
+	if !config.AuthCode.IsNull() || config.AdditionalData.IsUnknown() {
+		return
+	}
+
+	// Fields whose values are not known yet are checked during apply
+	unknown := make(map[string]bool)
+	set := make(map[string]bool)
+	for name, value := range config.AdditionalData.Attributes() {
+		unknown[name] = value.IsUnknown()
+		set[name] = !value.IsNull() && !value.IsUnknown()
+	}
+
+	data := convertAdditionalDataToAPI(ctx, config.AdditionalData, diags)
+	for _, problem := range domains.ValidateAdditionalData(extension, data) {
+		if !unknown[problem.Field] && set[problem.Field] != missing {
+			addAdditionalDataError(problem, data != nil, diags)
+		}
+	}
+}
+
+// addAdditionalDataError adds an error for a problem with the additional data,
+// pointing at the field when additional_data is set.
+func addAdditionalDataError(problem client.FieldError, hasData bool, diags *diag.Diagnostics) {
+	attributePath := path.Root("additional_data")
+	if hasData {
+		attributePath = attributePath.AtName(problem.Field)
+	}
+	diags.AddAttributeError(
+		attributePath,
+		"Invalid Additional Data",
+		fmt.Sprintf("additional_data.%s %s.", problem.Field, problem.Message),
+	)
+}
+
+// validateNameservers checks the number of nameservers and their glue addresses.
+func validateNameservers(ctx context.Context, config DomainModel, diags *diag.Diagnostics) {
+	if config.Nameservers.IsNull() || config.Nameservers.IsUnknown() {
+		return
+	}
+
+	if n := len(config.Nameservers.Elements()); n < minNameservers || n > maxNameservers {
+		diags.AddAttributeError(
+			path.Root("nameservers"),
+			"Invalid Number of Nameservers",
+			fmt.Sprintf("A domain needs between %d and %d nameservers, got %d.", minNameservers, maxNameservers, n),
+		)
+	}
+
+	var nameservers []NameserverModel
+	diags.Append(config.Nameservers.ElementsAs(ctx, &nameservers, false)...)
+	for _, ns := range nameservers {
+		if ip := ns.IP.ValueString(); ip != "" {
+			if parsed := net.ParseIP(ip); parsed == nil || parsed.To4() == nil {
+				diags.AddAttributeError(
+					path.Root("nameservers"),
+					"Invalid Nameserver Address",
+					fmt.Sprintf("ip of nameserver %s must be an IPv4 address, got %q.", ns.Name.ValueString(), ip),
+				)
+			}
+		}
+		if ip6 := ns.IP6.ValueString(); ip6 != "" {
+			if parsed := net.ParseIP(ip6); parsed == nil || parsed.To4() != nil {
+				diags.AddAttributeError(
+					path.Root("nameservers"),
+					"Invalid Nameserver Address",
+					fmt.Sprintf("ip6 of nameserver %s must be an IPv6 address, got %q.", ns.Name.ValueString(), ip6),
+				)
+			}
+		}
+	}
+}
+
+// ModifyPlan checks registrations for missing additional data, quotes their
+// premium price and checks owner changes, which depend on the prior state, so
+// that they fail at plan time rather than during apply. Existing domains are not
+// checked for additional data, as it is not read back from OpenProvider. A
+// pending owner change is no longer tracked once owner_handle matches the current
+// owner again.
+func (r *DomainResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	if req.State.Raw.IsNull() {
+		r.planRegistration(ctx, req, resp)
+		return
+	}
+
+	var plan, state DomainModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client != nil && plan.IsPrivateWhois.ValueBool() && !state.IsPrivateWhois.ValueBool() {
+		if parsed, err := domainname.Parse(plan.Domain.ValueString()); err == nil {
+			r.checkPrivateWhois(ctx, plan.Domain.ValueString(), parsed.Extension, &resp.Diagnostics)
+		}
+	}
+
+	if plan.OwnerHandle.IsUnknown() {
+		return
+	}
+
+	if plan.OwnerHandle.Equal(state.OwnerHandle) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("pending_owner_handle"), types.StringNull())...)
+		return
+	}
+
+	parsed, err := domainname.Parse(plan.Domain.ValueString())
+	if err != nil {
+		return
+	}
+	r.checkOwnerChange(plan, state, parsed.Extension, &resp.Diagnostics)
+}
+
+// planRegistration checks that a registration carries the additional data its
+// registry requires and that its extension allows WHOIS privacy protection when
+// enabled, sets premium_price in the plan to the price quoted by
+// OpenProvider and checks that accept_premium_price covers it.
+func (r *DomainResource) planRegistration(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan DomainModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Domain.IsUnknown() {
+		// Invalid names are reported by ValidateConfig
+		if parsed, err := domainname.Parse(plan.Domain.ValueString()); err == nil {
+			validateAdditionalData(ctx, plan, parsed.Extension, true, &resp.Diagnostics)
+			if r.client != nil && plan.IsPrivateWhois.ValueBool() {
+				r.checkPrivateWhois(ctx, plan.Domain.ValueString(), parsed.Extension, &resp.Diagnostics)
+			}
+		}
+	}
+
+	// The client is not configured yet when planning with unknown provider settings
+	if r.client == nil || plan.Domain.IsUnknown() || plan.AuthCode.IsUnknown() {
+		return
+	}
+
+	// Transfers keep the domain's price
+	var price *domains.Amount
+	if plan.AuthCode.ValueString() == "" {
+		var err error
+		price, err = domains.PremiumPrice(ctx, r.client, plan.Domain.ValueString())
+		if err != nil {
+			// Invalid names are reported by ValidateConfig
+			if !errors.Is(err, domainname.ErrInvalidDomainName) {
+				resp.Diagnostics.AddError(
+					"Error Checking Domain Price",
+					fmt.Sprintf("Could not check whether %s has a premium price: %s", plan.Domain.ValueString(), err.Error()),
+				)
+			}
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("premium_price"), premiumPriceValue(price))...)
+	if price != nil {
+		checkPremiumPrice(ctx, plan.AcceptPremiumPrice, plan.Domain.ValueString(), *price, &resp.Diagnostics)
+	}
+}
+
+// checkPrivateWhois reports an error when the TLD catalogue of OpenProvider does
+// not allow WHOIS privacy protection for extension. Extensions missing from the
+// catalogue are left to OpenProvider to accept or reject.
+func (r *DomainResource) checkPrivateWhois(ctx context.Context, domainName, extension string, diags *diag.Diagnostics) {
+	tld, err := tlds.Get(ctx, r.client, extension, false)
+	if err != nil {
+		if !client.IsNotFound(err) {
+			diags.AddError(
+				"Error Reading TLD",
+				fmt.Sprintf("Could not check whether .%s allows WHOIS privacy protection: %s", extension, err.Error()),
+			)
+		}
+		return
+	}
+
+	if !tld.IsPrivateWhoisAllowed {
+		diags.AddAttributeError(
+			path.Root("is_private_whois_enabled"),
+			"WHOIS Privacy Not Supported",
+			fmt.Sprintf("OpenProvider does not offer WHOIS privacy protection for .%s, so is_private_whois_enabled cannot be enabled for %s.",
+				tld.Name, domainName),
+		)
+	}
+}
+
+// checkPremiumPrice reports whether accepted covers the premium price of the
+// domain. An accepted price that is not known yet is checked again during apply.
+func checkPremiumPrice(ctx context.Context, accepted types.Object, domainName string, price domains.Amount, diags *diag.Diagnostics) bool {
+	if accepted.IsUnknown() {
+		return true
+	}
+
+	if !accepted.IsNull() {
+		var model PremiumPriceModel
+		diags.Append(accepted.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return false
+		}
+		if model.Amount.IsUnknown() || model.Currency.IsUnknown() {
+			return true
+		}
+
+		amount := domains.Amount{Currency: model.Currency.ValueString(), Price: model.Amount.ValueFloat64()}
+		if amount.Covers(price) {
+			return true
+		}
+	}
+
+	diags.AddAttributeError(
+		path.Root("accept_premium_price"),
+		"Premium Price Not Accepted",
+		fmt.Sprintf("%s is a premium domain: registering it costs %.2f %s. "+
+			"Set accept_premium_price = { amount = %g, currency = %q } or higher to accept the price and register the domain.",
+			domainName, price.Price, price.Currency, price.Price, price.Currency),
+	)
+	return false
+}
+
+// premiumPriceValue converts a premium price to its Terraform value, which is
+// null for domains without a premium price.
+func premiumPriceValue(price *domains.Amount) types.Object {
+	if price == nil {
+		return types.ObjectNull(premiumPriceAttrTypes)
+	}
+	return types.ObjectValueMust(premiumPriceAttrTypes, map[string]attr.Value{
+		"amount":   types.Float64Value(price.Price),
+		"currency": types.StringValue(price.Currency),
+	})
+}
+
+// checkOwnerChange reports whether owner_handle may change from state to plan:
+// trades need billable operations to be allowed, and owner changes that lock
+// the domain against transfers need accept_owner_change_lock.
+func (r *DomainResource) checkOwnerChange(plan, state DomainModel, extension string, diags *diag.Diagnostics) bool {
+	if domains.RequiresTrade(extension) {
+		// The client is not configured yet when planning with unknown provider settings
+		return r.client == nil || requireBillable(r.client, "domain trade", diags)
+	}
+
+	if domains.OwnerChangeLocksTransfers(extension) && !plan.AcceptOwnerChangeLock.IsUnknown() && !plan.AcceptOwnerChangeLock.ValueBool() {
+		diags.AddAttributeError(
+			path.Root("owner_handle"),
+			"Owner Change Not Acknowledged",
+			fmt.Sprintf("Changing the owner of %s from %s to %s locks the domain against transfers to another registrar for 60 days. "+
+				"Set accept_owner_change_lock = true to acknowledge the lock and change the owner.",
+				plan.Domain.ValueString(), state.OwnerHandle.ValueString(), plan.OwnerHandle.ValueString()),
+		)
+		return false
+	}
+
+	return true
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *DomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan DomainModel
@@ -236,26 +835,37 @@ func (r *DomainResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	// Parse domain name into name and extension
+	// Parse domain name into name and extension (e.g., example + co.uk)
 	domainName := plan.Domain.ValueString()
-	parts := strings.Split(domainName, ".")
-	if len(parts) < 2 {
+	parsed, err := domainname.Parse(domainName)
+	if err != nil {
 		resp.Diagnostics.AddError(
-			"Invalid Domain Domain",
-			fmt.Sprintf("Domain name must include extension (e.g., example.com), got: %s", domainName),
+			"Invalid Domain Name",
+			fmt.Sprintf("Domain name must be a registrable domain such as example.com: %s", err.Error()),
 		)
 		return
 	}
 
-	name := strings.Join(parts[:len(parts)-1], ".")
-	extension := parts[len(parts)-1]
+	name := parsed.Label
+	extension := parsed.Extension
 
 	var domain *domains.Domain
-	var err error
+
+	// Problems after the domain exists are reported after saving state, so that the
+	// domain stays tracked.
+	var afterDiags diag.Diagnostics
 
 	// Check if this is a transfer (auth_code provided) or a new registration
 	isTransfer := !plan.AuthCode.IsNull() && plan.AuthCode.ValueString() != ""
 
+	operation := "domain registration"
+	if isTransfer {
+		operation = "domain transfer"
+	}
+	if !requireBillable(r.client, operation, &resp.Diagnostics) {
+		return
+	}
+
 	if isTransfer {
 		// Domain Transfer
 		transferReq := &domains.TransferDomainRequest{}
@@ -284,7 +894,24 @@ func (r *DomainResource) Create(ctx context.Context, req resource.CreateRequest,
 			transferReq.NSGroup = plan.NSGroup.ValueString()
 		}
 
-		domain, err = domains.Transfer(r.client, transferReq)
+		transferReq.Nameservers = convertNameserversToAPI(ctx, plan.Nameservers, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if !plan.IsPrivateWhois.IsNull() && !plan.IsPrivateWhois.IsUnknown() {
+			private := plan.IsPrivateWhois.ValueBool()
+			transferReq.IsPrivateWhois = &private
+		}
+
+		transferReq.AdditionalData = convertAdditionalDataToAPI(ctx, plan.AdditionalData, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		plan.PremiumPrice = premiumPriceValue(nil)
+
+		domain, err = domains.Transfer(ctx, r.client, transferReq)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Transferring Domain",
@@ -292,6 +919,16 @@ func (r *DomainResource) Create(ctx context.Context, req resource.CreateRequest,
 			)
 			return
 		}
+
+		if plan.WaitForTransfer.ValueBool() && domain.Status != domains.StatusActive {
+			timeout, diags := plan.Timeouts.Create(ctx, defaultWaitTimeout)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			domain = r.waitForTransfer(ctx, domain, domainName, timeout, &afterDiags)
+		}
 	} else {
 		// Domain Registration
 		createReq := &domains.CreateDomainRequest{}
@@ -331,6 +968,12 @@ func (r *DomainResource) Create(ctx context.Context, req resource.CreateRequest,
 			createReq.NSGroup = plan.NSGroup.ValueString()
 		}
 
+		// Set nameservers if specified
+		createReq.Nameservers = convertNameserversToAPI(ctx, plan.Nameservers, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
 		// Set DNSSEC keys if specified
 		createReq.DnssecKeys = convertDnssecKeysToAPI(ctx, plan.DnssecKeys, &resp.Diagnostics)
 		if resp.Diagnostics.HasError() {
@@ -343,8 +986,45 @@ func (r *DomainResource) Create(ctx context.Context, req resource.CreateRequest,
 			createReq.IsDnssecEnabled = &enabled
 		}
 
+		// Set WHOIS privacy if specified
+		if !plan.IsPrivateWhois.IsNull() && !plan.IsPrivateWhois.IsUnknown() {
+			private := plan.IsPrivateWhois.ValueBool()
+			createReq.IsPrivateWhois = &private
+		}
+
+		// Set registry-specific registrant data, checked against the registry's
+		// requirements now that all values are known
+		createReq.AdditionalData = convertAdditionalDataToAPI(ctx, plan.AdditionalData, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		for _, problem := range domains.ValidateAdditionalData(extension, createReq.AdditionalData) {
+			addAdditionalDataError(problem, createReq.AdditionalData != nil, &resp.Diagnostics)
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Premium prices are quoted again, as they may have changed since the plan
+		var price *domains.Amount
+		price, err = domains.PremiumPrice(ctx, r.client, domainName)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Checking Domain Price",
+				fmt.Sprintf("Could not check whether %s has a premium price: %s", domainName, err.Error()),
+			)
+			return
+		}
+		if price != nil {
+			if !checkPremiumPrice(ctx, plan.AcceptPremiumPrice, domainName, *price, &resp.Diagnostics) {
+				return
+			}
+			createReq.AcceptPremiumFee = price.Price
+		}
+		plan.PremiumPrice = premiumPriceValue(price)
+
 		// Create the domain
-		domain, err = domains.Create(r.client, createReq)
+		domain, err = domains.Create(ctx, r.client, createReq)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Creating Domain",
@@ -354,22 +1034,25 @@ func (r *DomainResource) Create(ctx context.Context, req resource.CreateRequest,
 		}
 	}
 
+	// Registrations and transfers do not take the transfer lock, so it is set afterwards
+	plan.IsLocked = r.applyTransferLock(ctx, plan.IsLocked, domain, domainName, &afterDiags)
+
 	// Set ID to the domain name
 	plan.ID = types.StringValue(domainName)
+	plan.PendingOwnerHandle = types.StringNull()
 
 	// Update plan with computed values from the API response
 	plan.Status = types.StringValue(domain.Status)
 
 	// Map contact handles from response
 	plan.OwnerHandle = types.StringValue(domain.OwnerHandle)
-	if domain.AdminHandle != "" {
-		plan.AdminHandle = types.StringValue(domain.AdminHandle)
-	}
-	if domain.TechHandle != "" {
-		plan.TechHandle = types.StringValue(domain.TechHandle)
-	}
-	if domain.BillingHandle != "" {
-		plan.BillingHandle = types.StringValue(domain.BillingHandle)
+	plan.AdminHandle = types.StringValue(domain.AdminHandle)
+	plan.TechHandle = types.StringValue(domain.TechHandle)
+	plan.BillingHandle = types.StringValue(domain.BillingHandle)
+
+	// The API does not report the period, so an unset one stays null
+	if plan.Period.IsUnknown() {
+		plan.Period = types.Int64Null()
 	}
 
 	// Map autorenew from response
@@ -395,6 +1078,12 @@ func (r *DomainResource) Create(ctx context.Context, req resource.CreateRequest,
 	// Map DNSSEC enabled status from response
 	plan.IsDnssecEnabled = types.BoolValue(domain.IsDnssecEnabled)
 
+	// Keep the planned WHOIS privacy, which a pending transfer may not report yet;
+	// Read detects real drift
+	if plan.IsPrivateWhois.IsNull() || plan.IsPrivateWhois.IsUnknown() {
+		plan.IsPrivateWhois = types.BoolValue(domain.IsPrivateWhois)
+	}
+
 	// Map expiration date if present
 	if domain.ExpirationDate != "" {
 		plan.ExpirationDate = types.StringValue(domain.ExpirationDate)
@@ -405,6 +1094,41 @@ func (r *DomainResource) Create(ctx context.Context, req resource.CreateRequest,
 	// Save state
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(afterDiags...)
+}
+
+// applyTransferLock sets the transfer lock of a new domain to the configured
+// value and returns the value for state. The lock of a domain that is not active
+// yet, such as a pending transfer, is left to the next apply.
+func (r *DomainResource) applyTransferLock(ctx context.Context, configured types.Bool, domain *domains.Domain, domainName string, diags *diag.Diagnostics) types.Bool {
+	if configured.IsNull() || configured.IsUnknown() {
+		return types.BoolValue(domain.IsLocked)
+	}
+
+	locked := configured.ValueBool()
+	if locked == domain.IsLocked {
+		return configured
+	}
+
+	if domain.Status != domains.StatusActive {
+		diags.AddWarning(
+			"Transfer Lock Not Applied Yet",
+			fmt.Sprintf("Domain %s has status %s, so is_locked = %t cannot be applied yet. "+
+				"Once the domain is active, the next plan shows the difference and applying it sets the lock.",
+				domainName, domain.Status, locked),
+		)
+		return configured
+	}
+
+	if _, err := domains.Update(ctx, r.client, domain.ID, &domains.UpdateDomainRequest{IsLocked: &locked}); err != nil {
+		diags.AddError(
+			"Error Setting Transfer Lock",
+			fmt.Sprintf("Domain %s was created, but its transfer lock could not be set: %s", domainName, err.Error()),
+		)
+		return types.BoolValue(domain.IsLocked)
+	}
+
+	return configured
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -418,8 +1142,8 @@ func (r *DomainResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 	domainName := state.Domain.ValueString()
 
-	// Get domain by name (we need to find it via list since API uses ID)
-	domain, err := getDomainByName(r.client, domainName)
+	// Look the domain up by name (the API addresses domains by ID)
+	domain, err := domains.GetByName(ctx, r.client, domainName)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Domain",
@@ -441,6 +1165,10 @@ func (r *DomainResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 	// Map contact handles
 	state.OwnerHandle = types.StringValue(domain.OwnerHandle)
+	if state.PendingOwnerHandle.IsUnknown() || state.PendingOwnerHandle.ValueString() == domain.OwnerHandle {
+		// The pending owner change, if any, has completed
+		state.PendingOwnerHandle = types.StringNull()
+	}
 	state.AdminHandle = types.StringValue(domain.AdminHandle)
 	state.TechHandle = types.StringValue(domain.TechHandle)
 	state.BillingHandle = types.StringValue(domain.BillingHandle)
@@ -459,6 +1187,19 @@ func (r *DomainResource) Read(ctx context.Context, req resource.ReadRequest, res
 		state.NSGroup = types.StringNull()
 	}
 
+	// Map nameservers from response, if they are managed. Domains delegated to an NS
+	// group report the group's nameservers, which are not drift.
+	if !state.Nameservers.IsNull() {
+		var apiNameservers []domains.Nameserver
+		if domain.NSGroup == "" {
+			apiNameservers = domain.Nameservers
+		}
+		state.Nameservers = mapNameserversToState(ctx, apiNameservers, state.Nameservers, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// Map DNSSEC keys from response
 	state.DnssecKeys = mapDnssecKeysToState(ctx, domain.DnssecKeys, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
@@ -468,6 +1209,12 @@ func (r *DomainResource) Read(ctx context.Context, req resource.ReadRequest, res
 	// Map DNSSEC enabled status from response
 	state.IsDnssecEnabled = types.BoolValue(domain.IsDnssecEnabled)
 
+	// Map the transfer lock from response
+	state.IsLocked = types.BoolValue(domain.IsLocked)
+
+	// Map WHOIS privacy from response
+	state.IsPrivateWhois = types.BoolValue(domain.IsPrivateWhois)
+
 	// Map expiration date if present
 	if domain.ExpirationDate != "" {
 		state.ExpirationDate = types.StringValue(domain.ExpirationDate)
@@ -495,7 +1242,7 @@ func (r *DomainResource) Update(ctx context.Context, req resource.UpdateRequest,
 	domainName := state.Domain.ValueString()
 
 	// Get domain to get its ID
-	domain, err := getDomainByName(r.client, domainName)
+	domain, err := domains.GetByName(ctx, r.client, domainName)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Finding Domain",
@@ -512,6 +1259,15 @@ func (r *DomainResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	// Owner changes are requested separately from the other changes, and only
+	// once: a change that is still pending is waited for instead.
+	ownerChange := !plan.OwnerHandle.Equal(state.OwnerHandle)
+	requestOwnerChange := ownerChange && !plan.OwnerHandle.Equal(state.PendingOwnerHandle)
+	trade := domains.RequiresTrade(domain.Domain.Extension)
+	if requestOwnerChange && !r.checkOwnerChange(plan, state, domain.Domain.Extension, &resp.Diagnostics) {
+		return
+	}
+
 	// Check if there are any actual user-configured changes.
 	// Note: Handle fields (AdminHandle, TechHandle, BillingHandle) only detect changes when
 	// the plan value is non-null. This is intentional: clearing a handle (changing from value
@@ -523,8 +1279,12 @@ func (r *DomainResource) Update(ctx context.Context, req resource.UpdateRequest,
 		(!plan.BillingHandle.Equal(state.BillingHandle) && !plan.BillingHandle.IsNull()) ||
 		!plan.Autorenew.Equal(state.Autorenew) ||
 		!plan.NSGroup.Equal(state.NSGroup) ||
+		!plan.Nameservers.Equal(state.Nameservers) ||
 		!plan.DnssecKeys.Equal(state.DnssecKeys) ||
-		!plan.IsDnssecEnabled.Equal(state.IsDnssecEnabled)
+		!plan.IsDnssecEnabled.Equal(state.IsDnssecEnabled) ||
+		(!plan.IsLocked.IsUnknown() && !plan.IsLocked.Equal(state.IsLocked)) ||
+		(!plan.IsPrivateWhois.IsUnknown() && !plan.IsPrivateWhois.Equal(state.IsPrivateWhois)) ||
+		!plan.AdditionalData.Equal(state.AdditionalData)
 
 	// If no changes detected, skip the API call and just refresh state to pick up any
 	// server-side changes (e.g., DNSSEC keys or other computed fields updated by the API).
@@ -532,7 +1292,14 @@ func (r *DomainResource) Update(ctx context.Context, req resource.UpdateRequest,
 	// Unlike other resources that always call Update regardless of field changes, this manual
 	// change detection prevents redundant API calls for resources with computed fields that
 	// can be updated by the API independently.
-	if !hasChanges {
+	// The API does not report the period, so an unset one stays null
+	if plan.Period.IsUnknown() {
+		plan.Period = types.Int64Null()
+	}
+
+	if !hasChanges && !ownerChange {
+		// Refresh from the plan, which carries the attributes the API does not report
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 		var readReq resource.ReadRequest
 		readReq.State = resp.State
 		var readResp resource.ReadResponse
@@ -540,13 +1307,18 @@ func (r *DomainResource) Update(ctx context.Context, req resource.UpdateRequest,
 		r.Read(ctx, readReq, &readResp)
 		resp.State = readResp.State
 		resp.Diagnostics.Append(readResp.Diagnostics...)
+		r.awaitPendingTransfer(ctx, plan, domain, resp)
 		return
 	}
 
 	// Create update request with only changed mutable attributes
-	// Note: OwnerHandle is not updatable (typically immutable after domain creation)
 	updateReq := &domains.UpdateDomainRequest{}
 
+	// Change the owner through the update, unless the registry needs a trade
+	if requestOwnerChange && !trade {
+		updateReq.OwnerHandle = plan.OwnerHandle.ValueString()
+	}
+
 	// Update contact handles if changed
 	// Only set values if they are not null in the plan
 	if !plan.AdminHandle.Equal(state.AdminHandle) && !plan.AdminHandle.IsNull() {
@@ -580,6 +1352,15 @@ func (r *DomainResource) Update(ctx context.Context, req resource.UpdateRequest,
 		}
 	}
 
+	// Update nameservers if changed. Removing them leaves the current nameservers in
+	// place, since a domain cannot be left without nameservers.
+	if !plan.Nameservers.Equal(state.Nameservers) {
+		updateReq.Nameservers = convertNameserversToAPI(ctx, plan.Nameservers, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// Update DNSSEC keys if changed
 	if !plan.DnssecKeys.Equal(state.DnssecKeys) {
 		updateReq.DnssecKeys = convertDnssecKeysToAPI(ctx, plan.DnssecKeys, &resp.Diagnostics)
@@ -600,17 +1381,158 @@ func (r *DomainResource) Update(ctx context.Context, req resource.UpdateRequest,
 		}
 	}
 
+	// Update the transfer lock if changed
+	if !plan.IsLocked.IsUnknown() && !plan.IsLocked.Equal(state.IsLocked) {
+		locked := plan.IsLocked.ValueBool()
+		updateReq.IsLocked = &locked
+	}
+
+	// Update WHOIS privacy if changed
+	if !plan.IsPrivateWhois.IsUnknown() && !plan.IsPrivateWhois.Equal(state.IsPrivateWhois) {
+		private := plan.IsPrivateWhois.ValueBool()
+		updateReq.IsPrivateWhois = &private
+	}
+
+	// Update additional data if changed. Removing it leaves the registry's data as
+	// it is.
+	if !plan.AdditionalData.Equal(state.AdditionalData) {
+		updateReq.AdditionalData = convertAdditionalDataToAPI(ctx, plan.AdditionalData, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// Send update
-	_, err = domains.Update(r.client, domain.ID, updateReq)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Updating Domain",
-			fmt.Sprintf("Could not update domain %s: %s", domainName, err.Error()),
+	if hasChanges || updateReq.OwnerHandle != "" {
+		_, err = domains.Update(ctx, r.client, domain.ID, updateReq)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Domain",
+				fmt.Sprintf("Could not update domain %s: %s", domainName, err.Error()),
+			)
+			return
+		}
+	}
+
+	if requestOwnerChange && trade {
+		tradeReq := &domains.TradeDomainRequest{OwnerHandle: plan.OwnerHandle.ValueString()}
+		tradeReq.Domain.Name = domain.Domain.Name
+		tradeReq.Domain.Extension = domain.Domain.Extension
+		tradeReq.AdminHandle = plan.AdminHandle.ValueString()
+		tradeReq.TechHandle = plan.TechHandle.ValueString()
+		tradeReq.BillingHandle = plan.BillingHandle.ValueString()
+
+		if _, err := domains.Trade(ctx, r.client, tradeReq); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Trading Domain",
+				fmt.Sprintf("Could not trade domain %s to owner %s: %s", domainName, plan.OwnerHandle.ValueString(), err.Error()),
+			)
+			return
+		}
+	}
+
+	var ownerDiags diag.Diagnostics
+	if ownerChange {
+		r.waitForOwnerChange(ctx, plan, domain, &ownerDiags)
+	}
+
+	// Call Read to refresh the state, starting from the plan
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	var readReq resource.ReadRequest
+	readReq.State = resp.State
+	var readResp resource.ReadResponse
+	readResp.State = resp.State
+	r.Read(ctx, readReq, &readResp)
+	resp.State = readResp.State
+	resp.Diagnostics.Append(readResp.Diagnostics...)
+
+	// State keeps the refreshed WHOIS privacy, so that the next apply requests a
+	// change that did not take effect again
+	if !resp.State.Raw.IsNull() && !plan.IsPrivateWhois.IsNull() && !plan.IsPrivateWhois.IsUnknown() {
+		var private types.Bool
+		resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("is_private_whois_enabled"), &private)...)
+		if !private.Equal(plan.IsPrivateWhois) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("is_private_whois_enabled"),
+				"WHOIS Privacy Not Applied",
+				fmt.Sprintf("is_private_whois_enabled was set to %t for domain %s, but OpenProvider still reports %t. "+
+					"Pending transfers do not apply WHOIS privacy yet; apply again once the transfer has completed.",
+					plan.IsPrivateWhois.ValueBool(), domainName, private.ValueBool()),
+			)
+		}
+	}
+
+	if ownerDiags.HasError() {
+		// Keep track of the requested change, so that it is not requested again
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("pending_owner_handle"), plan.OwnerHandle)...)
+	}
+	resp.Diagnostics.Append(ownerDiags...)
+	r.awaitPendingTransfer(ctx, plan, domain, resp)
+}
+
+// waitForOwnerChange waits up to the update timeout for the owner change of domain
+// to plan.OwnerHandle to complete. An expired timeout is added to diags.
+func (r *DomainResource) waitForOwnerChange(ctx context.Context, plan DomainModel, domain *domains.Domain, diags *diag.Diagnostics) {
+	timeout, timeoutDiags := plan.Timeouts.Update(ctx, defaultWaitTimeout)
+	diags.Append(timeoutDiags...)
+	if diags.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	domainName := plan.Domain.ValueString()
+	ownerHandle := plan.OwnerHandle.ValueString()
+	tflog.Info(ctx, "Waiting for domain owner change to complete", map[string]any{
+		"domain":  domainName,
+		"owner":   ownerHandle,
+		"timeout": timeout.String(),
+	})
+
+	_, err := domains.WaitForOwnerChange(ctx, r.client, domain.ID, ownerHandle, &domains.WaitOptions{Interval: r.waitInterval})
+	switch {
+	case err == nil:
+		tflog.Info(ctx, "Domain owner change completed", map[string]any{"domain": domainName})
+	case errors.Is(err, context.DeadlineExceeded):
+		diags.AddError(
+			"Timeout Waiting for Owner Change",
+			fmt.Sprintf("The owner change of domain %s to %s was requested but did not complete within %s. "+
+				"Owner changes of generic extensions complete once the current and new registrant confirm them by email, and trades once the registry processes them. "+
+				"The change stays pending: applying again waits for it without requesting it again.",
+				domainName, ownerHandle, timeout),
 		)
+	default:
+		diags.AddError(
+			"Error Waiting for Owner Change",
+			fmt.Sprintf("Could not read the owner of domain %s: %s", domainName, err.Error()),
+		)
+	}
+}
+
+// awaitPendingTransfer waits for a transfer that is still pending after an update
+// when wait_for_transfer is set, for instance after enabling it, and refreshes the
+// state.
+func (r *DomainResource) awaitPendingTransfer(ctx context.Context, plan DomainModel, domain *domains.Domain, resp *resource.UpdateResponse) {
+	if resp.Diagnostics.HasError() || !plan.WaitForTransfer.ValueBool() || plan.AuthCode.IsNull() {
+		return
+	}
+
+	var status types.String
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("status"), &status)...)
+	if resp.Diagnostics.HasError() || status.ValueString() != domains.StatusRequested {
 		return
 	}
 
-	// Call Read to refresh the state
+	timeout, diags := plan.Timeouts.Update(ctx, defaultWaitTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var waitDiags diag.Diagnostics
+	r.waitForTransfer(ctx, domain, plan.Domain.ValueString(), timeout, &waitDiags)
+
 	var readReq resource.ReadRequest
 	readReq.State = resp.State
 	var readResp resource.ReadResponse
@@ -618,14 +1540,146 @@ func (r *DomainResource) Update(ctx context.Context, req resource.UpdateRequest,
 	r.Read(ctx, readReq, &readResp)
 	resp.State = readResp.State
 	resp.Diagnostics.Append(readResp.Diagnostics...)
+	resp.Diagnostics.Append(waitDiags...)
 }
 
-// Delete prevents deletion of domains as a safety measure.
-func (r *DomainResource) Delete(_ context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
-	resp.Diagnostics.AddError(
-		"Domain Deletion Not Allowed",
-		"Domains cannot be deleted through this provider as a safety measure. Domain deletions are irreversible and must be performed manually outside of Terraform. To stop managing a domain, remove it from your Terraform configuration.",
-	)
+// waitForTransfer waits up to timeout for the transfer of domain to complete and
+// returns the domain as last read. A failed transfer or an expired timeout is added
+// to diags.
+func (r *DomainResource) waitForTransfer(ctx context.Context, domain *domains.Domain, domainName string, timeout time.Duration, diags *diag.Diagnostics) *domains.Domain {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	tflog.Info(ctx, "Waiting for domain transfer to complete", map[string]any{
+		"domain":  domainName,
+		"timeout": timeout.String(),
+	})
+
+	waited, err := domains.WaitForTransfer(ctx, r.client, domain.ID, &domains.WaitOptions{
+		Interval: r.waitInterval,
+		Progress: func(d *domains.Domain) {
+			tflog.Info(ctx, "Domain transfer status", map[string]any{
+				"domain": domainName,
+				"status": d.Status,
+			})
+		},
+	})
+	if waited != nil {
+		domain = waited
+	}
+
+	var failed *domains.TransferFailedError
+	switch {
+	case err == nil:
+		tflog.Info(ctx, "Domain transfer completed", map[string]any{"domain": domainName})
+	case errors.As(err, &failed):
+		reason := failed.Domain.Reason
+		if reason == "" {
+			reason = "the registry gave no reason"
+		}
+		diags.AddError(
+			"Domain Transfer Failed",
+			fmt.Sprintf("The transfer of domain %s ended with status %s: %s. "+
+				"Check the auth_code and the domain's transfer lock at the current registrar, then taint or replace the resource to retry.",
+				domainName, failed.Domain.Status, reason),
+		)
+	case errors.Is(err, context.DeadlineExceeded):
+		diags.AddError(
+			"Timeout Waiting for Domain Transfer",
+			fmt.Sprintf("The transfer of domain %s did not complete within %s and still has status %s. "+
+				"Transfers can take several days; increase the create timeout or set wait_for_transfer = false.",
+				domainName, timeout, domain.Status),
+		)
+	default:
+		diags.AddError(
+			"Error Waiting for Domain Transfer",
+			fmt.Sprintf("Could not read the transfer status of domain %s: %s", domainName, err.Error()),
+		)
+	}
+
+	return domain
+}
+
+// Delete deletes the resource based on the allow_deletion flag and deletion_mode.
+// By default the domain is only removed from Terraform state.
+func (r *DomainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state DomainModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domainName := state.Domain.ValueString()
+
+	mode := domainDeletionStateOnly
+	if !state.AllowDeletion.IsNull() && state.AllowDeletion.ValueBool() && !state.DeletionMode.IsNull() {
+		mode = state.DeletionMode.ValueString()
+	}
+
+	if mode == domainDeletionStateOnly {
+		// Remove from state only - keep the domain registered in OpenProvider
+		resp.Diagnostics.AddWarning(
+			"Domain Removed from Terraform State Only",
+			fmt.Sprintf("Domain %s has been removed from your Terraform state but NOT deleted in OpenProvider. "+
+				"The domain stays registered and can be reimported with the ID %s. "+
+				"To delete it, set allow_deletion = true and deletion_mode = %q or %q on the resource.",
+				domainName, domainName, domainDeletionDelete, domainDeletionAtExpiry),
+		)
+		return
+	}
+
+	domain, err := domains.GetByName(ctx, r.client, domainName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Domain",
+			fmt.Sprintf("Could not find domain %s: %s", domainName, err.Error()),
+		)
+		return
+	}
+	if domain == nil {
+		// Already gone
+		return
+	}
+
+	switch mode {
+	case domainDeletionDelete:
+		if !requireBillable(r.client, "domain deletion", &resp.Diagnostics) {
+			return
+		}
+
+		if err := domains.Delete(ctx, r.client, domain.ID); err != nil {
+			if client.IsNotFound(err) {
+				return
+			}
+			resp.Diagnostics.AddError(
+				"Error Deleting Domain",
+				fmt.Sprintf("Could not delete domain %s: %s", domainName, err.Error()),
+			)
+		}
+
+	case domainDeletionAtExpiry:
+		if _, err := domains.Update(ctx, r.client, domain.ID, &domains.UpdateDomainRequest{Autorenew: "off"}); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Deleting Domain",
+				fmt.Sprintf("Could not turn off autorenew for domain %s: %s", domainName, err.Error()),
+			)
+			return
+		}
+
+		resp.Diagnostics.AddWarning(
+			"Domain Will Expire",
+			fmt.Sprintf("Autorenew has been turned off for domain %s and it has been removed from your Terraform state. "+
+				"The domain stays registered until it expires on %s.",
+				domainName, domain.ExpirationDate),
+		)
+
+	default:
+		resp.Diagnostics.AddError(
+			"Invalid Deletion Mode",
+			fmt.Sprintf("Unknown deletion_mode %q for domain %s.", mode, domainName),
+		)
+	}
 }
 
 // ImportState imports an existing resource into Terraform.
@@ -637,6 +1691,11 @@ func (r *DomainResource) ImportState(ctx context.Context, req resource.ImportSta
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), domainName)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain"), domainName)...)
 
+	// Imported domains are never deleted until deletion is explicitly configured
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("allow_deletion"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deletion_mode"), domainDeletionStateOnly)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("wait_for_transfer"), false)...)
+
 	// Note: auth_code cannot be retrieved from the API after transfer is initiated
 	// Users must provide it in their configuration if the domain was transferred
 	resp.Diagnostics.AddWarning(
@@ -644,22 +1703,3 @@ func (r *DomainResource) ImportState(ctx context.Context, req resource.ImportSta
 		"If this domain was transferred to OpenProvider, the authorization code cannot be retrieved from the API. You must provide the auth_code in your Terraform configuration after import, or the resource will show a diff on the next plan.",
 	)
 }
-
-// getDomainByName finds a domain by its name using the List API.
-// Returns nil if the domain is not found.
-func getDomainByName(c *client.Client, domainName string) (*domains.Domain, error) {
-	domainList, err := domains.List(c)
-	if err != nil {
-		return nil, err
-	}
-
-	// Search for domain by name
-	for _, domain := range domainList {
-		fullName := domain.Domain.Name + "." + domain.Domain.Extension
-		if fullName == domainName {
-			return &domain, nil
-		}
-	}
-
-	return nil, nil
-}
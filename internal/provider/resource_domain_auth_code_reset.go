@@ -0,0 +1,188 @@
+// Package provider implements the Terraform provider for OpenProvider.
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client"
+	"github.com/charpand/terraform-provider-openprovider/internal/client/domains"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &DomainAuthCodeResetResource{}
+	_ resource.ResourceWithConfigure = &DomainAuthCodeResetResource{}
+)
+
+// DomainAuthCodeResetResource is the resource implementation.
+type DomainAuthCodeResetResource struct {
+	client *client.Client
+}
+
+// DomainAuthCodeResetModel describes the resource data model.
+type DomainAuthCodeResetModel struct {
+	Domain   types.String `tfsdk:"domain"`
+	Triggers types.Map    `tfsdk:"triggers"`
+	DomainID types.Int64  `tfsdk:"domain_id"`
+	ID       types.String `tfsdk:"id"`
+}
+
+// NewDomainAuthCodeResetResource returns a new instance of the domain auth code reset resource.
+func NewDomainAuthCodeResetResource() resource.Resource {
+	return &DomainAuthCodeResetResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *DomainAuthCodeResetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_auth_code_reset"
+}
+
+// Schema defines the schema for the resource.
+func (r *DomainAuthCodeResetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Generates a new EPP/authorization code for a domain when the resource is created, and again whenever `triggers` change. The previous code stops working. The new code is not stored in state; read it with the `openprovider_domain_auth_code` ephemeral resource.",
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The domain name (e.g., example.com). Changing this resets the auth code of the new domain.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that reset the auth code again when they change.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"domain_id": schema.Int64Attribute{
+				MarkdownDescription: "The OpenProvider ID of the domain.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The domain name.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *DomainAuthCodeResetResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create resets the auth code of the domain and sets the initial Terraform state.
+func (r *DomainAuthCodeResetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan DomainAuthCodeResetModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domainName := plan.Domain.ValueString()
+
+	domain, err := domains.GetByName(ctx, r.client, domainName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Domain",
+			fmt.Sprintf("Could not read domain %s: %s", domainName, err.Error()),
+		)
+		return
+	}
+	if domain == nil {
+		resp.Diagnostics.AddError(
+			"Domain Not Found",
+			fmt.Sprintf("Domain %s does not exist in this OpenProvider account.", domainName),
+		)
+		return
+	}
+
+	// The new code is left to the ephemeral resource, so that it never reaches state
+	if _, err := domains.ResetAuthCode(ctx, r.client, domain.ID); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Resetting Auth Code",
+			fmt.Sprintf("Could not reset the auth code of domain %s: %s", domainName, err.Error()),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(domainName)
+	plan.DomainID = types.Int64Value(int64(domain.ID))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read removes the resource from state when the domain no longer exists.
+func (r *DomainAuthCodeResetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state DomainAuthCodeResetModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := domains.Get(ctx, r.client, int(state.DomainID.ValueInt64())); err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Domain",
+			fmt.Sprintf("Could not read domain %s: %s", state.Domain.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update is a no-op: every configurable attribute forces a new reset.
+func (r *DomainAuthCodeResetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan DomainAuthCodeResetModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the reset from Terraform state. A reset cannot be undone, so no
+// API request is made.
+func (r *DomainAuthCodeResetResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
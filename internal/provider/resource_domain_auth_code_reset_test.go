@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourcetest "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestDomainAuthCodeResetResourceMetadata(t *testing.T) {
+	r := NewDomainAuthCodeResetResource()
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "openprovider"}, resp)
+
+	if resp.TypeName != "openprovider_domain_auth_code_reset" {
+		t.Errorf("Expected TypeName openprovider_domain_auth_code_reset, got %s", resp.TypeName)
+	}
+}
+
+func TestAccDomainAuthCodeResetResource(t *testing.T) {
+	stub := newStubServer(t)
+
+	// resets checks that the auth code has been reset n times so far
+	resets := func(n int) resourcetest.TestCheckFunc {
+		return func(*terraform.State) error {
+			if got := stub.countRequests("POST /v1beta/domains/42/authcode/reset"); got != n {
+				return fmt.Errorf("expected %d resets, got %d", n, got)
+			}
+			return nil
+		}
+	}
+	config := func(handover string) string {
+		return stub.config(fmt.Sprintf(`
+resource "openprovider_domain_auth_code_reset" "test" {
+  domain = "example.com"
+
+  triggers = {
+    handover = %q
+  }
+}
+`, handover))
+	}
+
+	resourcetest.Test(t, resourcetest.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resourcetest.TestStep{
+			{
+				Config: config("first"),
+				Check: resourcetest.ComposeAggregateTestCheckFunc(
+					resourcetest.TestCheckResourceAttr("openprovider_domain_auth_code_reset.test", "id", "example.com"),
+					resourcetest.TestCheckResourceAttr("openprovider_domain_auth_code_reset.test", "domain_id", "42"),
+					resets(1),
+				),
+			},
+			// Plans and refreshes leave the code alone
+			refreshWithoutChanges,
+			{
+				Config: config("second"),
+				Check:  resets(2),
+			},
+		},
+	})
+}
@@ -0,0 +1,303 @@
+// Package provider implements the Terraform provider for OpenProvider.
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client"
+	"github.com/charpand/terraform-provider-openprovider/internal/client/domains"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &DomainRenewalResource{}
+	_ resource.ResourceWithConfigure      = &DomainRenewalResource{}
+	_ resource.ResourceWithValidateConfig = &DomainRenewalResource{}
+)
+
+// DomainRenewalResource is the resource implementation.
+type DomainRenewalResource struct {
+	client *client.Client
+}
+
+// DomainRenewalModel describes the resource data model.
+type DomainRenewalModel struct {
+	Domain           types.String  `tfsdk:"domain"`
+	TargetExpiryYear types.Int64   `tfsdk:"target_expiry_year"`
+	DomainID         types.Int64   `tfsdk:"domain_id"`
+	ExpirationDate   types.String  `tfsdk:"expiration_date"`
+	Period           types.Int64   `tfsdk:"period"`
+	QuotedPrice      types.Float64 `tfsdk:"quoted_price"`
+	Currency         types.String  `tfsdk:"currency"`
+	ID               types.String  `tfsdk:"id"`
+}
+
+// NewDomainRenewalResource returns a new instance of the domain renewal resource.
+func NewDomainRenewalResource() resource.Resource {
+	return &DomainRenewalResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *DomainRenewalResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_renewal"
+}
+
+// Schema defines the schema for the resource.
+func (r *DomainRenewalResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Renews a domain so that it does not expire before a target year. The domain is renewed only when its expiration date lies before `target_expiry_year`; raising the target renews it again.",
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The domain name to renew (e.g., example.com). Changing this forces a new renewal.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_expiry_year": schema.Int64Attribute{
+				MarkdownDescription: "The year the domain must not expire before. If the domain expires earlier, it is renewed for the number of years needed to expire in this year. Changing this forces a new renewal.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"domain_id": schema.Int64Attribute{
+				MarkdownDescription: "The OpenProvider ID of the domain.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"expiration_date": schema.StringAttribute{
+				MarkdownDescription: "The expiration date of the domain.",
+				Computed:            true,
+			},
+			"period": schema.Int64Attribute{
+				MarkdownDescription: "The number of years the domain was renewed for; 0 if it already expired in or after `target_expiry_year`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"quoted_price": schema.Float64Attribute{
+				MarkdownDescription: "The reseller price OpenProvider quoted for the renewal just before renewing, in `currency`. The renewal response does not report the amount charged, so check the invoice for it. Null if no renewal was needed.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"currency": schema.StringAttribute{
+				MarkdownDescription: "The currency of `quoted_price`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier for the renewal in the format `domain/target_expiry_year`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *DomainRenewalResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig validates the target expiry year.
+func (r *DomainRenewalResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config DomainRenewalModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.TargetExpiryYear.IsNull() || config.TargetExpiryYear.IsUnknown() {
+		return
+	}
+
+	if year := config.TargetExpiryYear.ValueInt64(); year < 2000 || year > 9999 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("target_expiry_year"),
+			"Invalid Target Expiry Year",
+			fmt.Sprintf("target_expiry_year must be a four-digit year, got %d.", year),
+		)
+	}
+}
+
+// Create renews the domain if needed and sets the initial Terraform state.
+func (r *DomainRenewalResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan DomainRenewalModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domainName := plan.Domain.ValueString()
+	targetYear := int(plan.TargetExpiryYear.ValueInt64())
+
+	domain, err := domains.GetByName(ctx, r.client, domainName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Domain",
+			fmt.Sprintf("Could not read domain %s: %s", domainName, err.Error()),
+		)
+		return
+	}
+	if domain == nil {
+		resp.Diagnostics.AddError(
+			"Domain Not Found",
+			fmt.Sprintf("Domain %s does not exist in this OpenProvider account.", domainName),
+		)
+		return
+	}
+
+	expiryYear, err := expirationYear(domain.ExpirationDate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Domain",
+			fmt.Sprintf("Could not determine the expiration year of domain %s: %s", domainName, err.Error()),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s/%d", domainName, targetYear))
+	plan.DomainID = types.Int64Value(int64(domain.ID))
+	plan.Period = types.Int64Value(0)
+	plan.QuotedPrice = types.Float64Null()
+	plan.Currency = types.StringNull()
+
+	// Nothing to do when the domain already lasts until the target year
+	if expiryYear < targetYear {
+		period := targetYear - expiryYear
+
+		if !requireBillable(r.client, "domain renewal", &resp.Diagnostics) {
+			return
+		}
+
+		// The renewal response carries no price, so it is quoted beforehand
+		price, err := domains.GetPrice(ctx, r.client, domain.Domain.Name, domain.Domain.Extension, domains.OperationRenew, period)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Renewing Domain",
+				fmt.Sprintf("Could not get the renewal price of domain %s: %s", domainName, err.Error()),
+			)
+			return
+		}
+
+		if err := domains.Renew(ctx, r.client, domain.ID, period); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Renewing Domain",
+				fmt.Sprintf("Could not renew domain %s for %d years: %s", domainName, period, err.Error()),
+			)
+			return
+		}
+
+		plan.Period = types.Int64Value(int64(period))
+		plan.QuotedPrice = types.Float64Value(price.Price.Reseller.Price)
+		plan.Currency = types.StringValue(price.Price.Reseller.Currency)
+
+		domain, err = domains.Get(ctx, r.client, domain.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Domain",
+				fmt.Sprintf("Domain %s was renewed, but its new expiration date could not be read: %s", domainName, err.Error()),
+			)
+			return
+		}
+	}
+
+	plan.ExpirationDate = types.StringValue(domain.ExpirationDate)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *DomainRenewalResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state DomainRenewalModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domain, err := domains.Get(ctx, r.client, int(state.DomainID.ValueInt64()))
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Domain",
+			fmt.Sprintf("Could not read domain %s: %s", state.Domain.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	state.ExpirationDate = types.StringValue(domain.ExpirationDate)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update is a no-op: every configurable attribute forces a new renewal.
+func (r *DomainRenewalResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan DomainRenewalModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the renewal from Terraform state. A renewal cannot be undone, so
+// no API request is made.
+func (r *DomainRenewalResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// expirationYear returns the year of an API date such as "2027-01-15 12:00:00".
+func expirationYear(date string) (int, error) {
+	if len(date) < len(time.DateOnly) {
+		return 0, fmt.Errorf("invalid date: %q", date)
+	}
+
+	t, err := time.Parse(time.DateOnly, date[:len(time.DateOnly)])
+	if err != nil {
+		return 0, fmt.Errorf("invalid date: %q", date)
+	}
+
+	return t.Year(), nil
+}
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourcetest "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestDomainRenewalResourceMetadata(t *testing.T) {
+	r := NewDomainRenewalResource()
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "openprovider"}, resp)
+
+	if resp.TypeName != "openprovider_domain_renewal" {
+		t.Errorf("Expected TypeName openprovider_domain_renewal, got %s", resp.TypeName)
+	}
+}
+
+func TestAccDomainRenewalResource(t *testing.T) {
+	stub := newStubServer(t)
+	config := func(targetYear int) string {
+		return fmt.Sprintf(`
+resource "openprovider_domain_renewal" "test" {
+  domain             = "example.com"
+  target_expiry_year = %d
+}
+`, targetYear)
+	}
+
+	resourcetest.Test(t, resourcetest.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resourcetest.TestStep{
+			{
+				Config:      stub.config(config(30)),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Target Expiry Year"),
+			},
+			{
+				// Renewals are billable
+				Config:      stub.configWithoutBillable(config(2030)),
+				ExpectError: regexp.MustCompile("Billable Operation Not Allowed"),
+			},
+			{
+				// No renewal is needed, so billable operations need not be allowed
+				Config: stub.configWithoutBillable(config(2027)),
+				Check: resourcetest.ComposeAggregateTestCheckFunc(
+					resourcetest.TestCheckResourceAttr("openprovider_domain_renewal.test", "period", "0"),
+					resourcetest.TestCheckNoResourceAttr("openprovider_domain_renewal.test", "quoted_price"),
+				),
+			},
+			{
+				Config: stub.config(config(2030)),
+				Check: resourcetest.ComposeAggregateTestCheckFunc(
+					resourcetest.TestCheckResourceAttr("openprovider_domain_renewal.test", "id", "example.com/2030"),
+					resourcetest.TestCheckResourceAttr("openprovider_domain_renewal.test", "period", "3"),
+					resourcetest.TestCheckResourceAttr("openprovider_domain_renewal.test", "expiration_date", "2030-03-01 10:00:00"),
+					resourcetest.TestCheckResourceAttr("openprovider_domain_renewal.test", "quoted_price", "9.75"),
+					resourcetest.TestCheckResourceAttr("openprovider_domain_renewal.test", "currency", "EUR"),
+				),
+			},
+			refreshWithoutChanges,
+			{
+				// Renewals are not imported; there is nothing to renew for an existing domain
+				ResourceName:  "openprovider_domain_renewal.test",
+				ImportState:   true,
+				ImportStateId: "example.com/2030",
+				ExpectError:   regexp.MustCompile(`Resource Import Not Implemented`),
+			},
+		},
+	})
+
+	if got := stub.countRequests("POST /v1beta/domains/42/renew"); got != 1 {
+		t.Errorf("Expected 1 renewal, got %d", got)
+	}
+}
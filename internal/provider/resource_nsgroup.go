@@ -125,7 +125,7 @@ func (r *NSGroupResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	// Create the NS group
-	group, err := nsgroups.Create(r.client, createReq)
+	group, err := nsgroups.Create(ctx, r.client, createReq)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Creating NS Group",
@@ -178,7 +178,12 @@ func (r *NSGroupResource) Read(ctx context.Context, req resource.ReadRequest, re
 	groupName := state.ID.ValueString()
 
 	// Get NS group
-	group, err := nsgroups.Get(r.client, groupName)
+	group, err := nsgroups.Get(ctx, r.client, groupName)
+	if client.IsNotFound(err) {
+		// NS group not found - remove from state
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading NS Group",
@@ -278,7 +283,7 @@ func (r *NSGroupResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 
 	// Send update
-	_, err := nsgroups.Update(r.client, groupName, updateReq)
+	_, err := nsgroups.Update(ctx, r.client, groupName, updateReq)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating NS Group",
@@ -4,9 +4,11 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/charpand/terraform-provider-openprovider/internal/client"
 	"github.com/charpand/terraform-provider-openprovider/internal/client/ssl"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -16,8 +18,9 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &SSLOrderResource{}
-	_ resource.ResourceWithConfigure = &SSLOrderResource{}
+	_ resource.Resource                = &SSLOrderResource{}
+	_ resource.ResourceWithConfigure   = &SSLOrderResource{}
+	_ resource.ResourceWithImportState = &SSLOrderResource{}
 )
 
 // SSLOrderResource is the resource implementation.
@@ -160,6 +163,10 @@ func (r *SSLOrderResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	if !requireBillable(r.client, "SSL certificate order", &resp.Diagnostics) {
+		return
+	}
+
 	var additionalDomains []string
 	if !plan.AdditionalDomains.IsNull() {
 		diags = plan.AdditionalDomains.ElementsAs(ctx, &additionalDomains, false)
@@ -194,7 +201,7 @@ func (r *SSLOrderResource) Create(ctx context.Context, req resource.CreateReques
 		createReq.Autorenew = "off"
 	}
 
-	order, err := ssl.CreateOrder(r.client, createReq)
+	order, err := ssl.CreateOrder(ctx, r.client, createReq)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating SSL order",
@@ -240,7 +247,12 @@ func (r *SSLOrderResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 	orderID := int(state.ID.ValueInt64())
 
-	order, err := ssl.GetOrder(r.client, orderID)
+	order, err := ssl.GetOrder(ctx, r.client, orderID)
+	if client.IsNotFound(err) {
+		// SSL order not found - remove from state
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading SSL order",
@@ -250,6 +262,8 @@ func (r *SSLOrderResource) Read(ctx context.Context, req resource.ReadRequest, r
 	}
 
 	// Update state
+	state.ProductID = types.Int64Value(int64(order.ProductID))
+	state.CommonName = types.StringValue(order.CommonName)
 	state.BrandName = types.StringValue(order.BrandName)
 	state.Status = types.StringValue(order.Status)
 	state.OrderDate = types.StringValue(order.OrderDate)
@@ -269,6 +283,14 @@ func (r *SSLOrderResource) Read(ctx context.Context, req resource.ReadRequest, r
 		state.AdditionalDomains = types.ListNull(types.StringType)
 	}
 
+	// Keep the configured method when the API does not report one, falling back
+	// to the schema default for imported orders
+	if order.DomainValidationMethod != "" {
+		state.DomainValidationMethod = types.StringValue(order.DomainValidationMethod)
+	} else if state.DomainValidationMethod.IsNull() {
+		state.DomainValidationMethod = types.StringValue("dns")
+	}
+
 	// Set state
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
@@ -292,7 +314,7 @@ func (r *SSLOrderResource) Update(ctx context.Context, req resource.UpdateReques
 		updateReq.Autorenew = "off"
 	}
 
-	order, err := ssl.UpdateOrder(r.client, orderID, updateReq)
+	order, err := ssl.UpdateOrder(ctx, r.client, orderID, updateReq)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating SSL order",
@@ -334,3 +356,15 @@ func (r *SSLOrderResource) Delete(ctx context.Context, req resource.DeleteReques
 			orderID, commonName),
 	)
 }
+
+// ImportState imports an existing resource into Terraform.
+func (r *SSLOrderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The import ID is the numeric order ID
+	orderID, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil || orderID <= 0 {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected a numeric SSL order ID, got %q", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), orderID)...)
+}
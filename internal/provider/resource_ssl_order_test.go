@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourcetest "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestSSLOrderResourceMetadata(t *testing.T) {
+	r := NewSSLOrderResource()
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "openprovider"}, resp)
+
+	if resp.TypeName != "openprovider_ssl_order" {
+		t.Errorf("Expected TypeName openprovider_ssl_order, got %s", resp.TypeName)
+	}
+}
+
+func TestAccSSLOrderResource(t *testing.T) {
+	stub := newStubServer(t)
+	imported := `
+import {
+  to = openprovider_ssl_order.test
+  id = "123"
+}
+
+resource "openprovider_ssl_order" "test" {
+  product_id         = 18
+  common_name        = "example.com"
+  autorenew          = true
+  additional_domains = ["www.example.com"]
+}
+`
+
+	resourcetest.Test(t, resourcetest.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resourcetest.TestStep{
+			{
+				Config: stub.config(imported),
+				Check: resourcetest.ComposeAggregateTestCheckFunc(
+					resourcetest.TestCheckResourceAttr("openprovider_ssl_order.test", "id", "123"),
+					resourcetest.TestCheckResourceAttr("openprovider_ssl_order.test", "owner_handle", "XX123456-XX"),
+					resourcetest.TestCheckResourceAttr("openprovider_ssl_order.test", "domain_validation_method", "dns"),
+				),
+			},
+			refreshWithoutChanges,
+			{
+				ResourceName:  "openprovider_ssl_order.test",
+				ImportState:   true,
+				ImportStateId: "example.com",
+				ExpectError:   regexp.MustCompile("Invalid Import ID"),
+			},
+			{
+				// Ordering a certificate is billable
+				Config: stub.configWithoutBillable(imported + `
+resource "openprovider_ssl_order" "new" {
+  product_id  = 18
+  common_name = "www.example.com"
+}
+`),
+				ExpectError: regexp.MustCompile("Billable Operation Not Allowed"),
+			},
+		},
+	})
+
+	if got := stub.countRequests("POST /v1beta/ssl/orders"); got != 0 {
+		t.Errorf("Expected no order, got %d", got)
+	}
+}
@@ -0,0 +1,695 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client/dns"
+	"github.com/charpand/terraform-provider-openprovider/internal/client/domains"
+	"github.com/charpand/terraform-provider-openprovider/internal/client/tlds"
+)
+
+// stubServer is an in-memory stand-in for the OpenProvider API that acceptance
+// tests run the provider against. It starts with the same fixture in every test:
+//   - example.com, domain 42, owned by XX123456-XX, expiring in 2027, with auth
+//     code "current" and WHOIS privacy enabled
+//   - the com, us, de and inactive example extensions in the TLD catalogue
+//   - SSL order 123 for example.com
+//
+// Every operation is quoted at 9.75 EUR.
+type stubServer struct {
+	url string
+
+	mu             sync.Mutex
+	domains        map[int]*domains.Domain
+	nextDomainID   int
+	price          domains.DomainPrice
+	premium        map[string]bool
+	statuses       map[string][]string
+	transferWhois  map[int]bool
+	additionalData map[string]*domains.AdditionalData
+	zones          map[string]*dns.Zone
+	records        map[string][]dns.Record
+	tlds           map[string]tlds.TLD
+	requests       []string
+
+	ownerChangesHeld bool
+	pendingOwners    map[int]string
+	settingsHeld     bool
+}
+
+// newStubServer starts a stub API holding the fixture for the duration of the test.
+func newStubServer(t *testing.T) *stubServer {
+	t.Helper()
+	s := &stubServer{
+		domains:        make(map[int]*domains.Domain),
+		nextDomainID:   1,
+		premium:        make(map[string]bool),
+		statuses:       make(map[string][]string),
+		transferWhois:  make(map[int]bool),
+		additionalData: make(map[string]*domains.AdditionalData),
+		zones:          make(map[string]*dns.Zone),
+		records:        make(map[string][]dns.Record),
+		tlds:           make(map[string]tlds.TLD),
+		pendingOwners:  make(map[int]string),
+	}
+	s.price.Price.Product = domains.Amount{Currency: "USD", Price: 10.5}
+	s.price.Price.Reseller = domains.Amount{Currency: "EUR", Price: 9.75}
+
+	domain := &domains.Domain{
+		ID:             42,
+		OwnerHandle:    "XX123456-XX",
+		Autorenew:      "on",
+		Status:         domains.StatusActive,
+		ExpirationDate: "2027-03-01 10:00:00",
+		AuthCode:       "current",
+		IsPrivateWhois: true,
+	}
+	domain.Domain.Name = "example"
+	domain.Domain.Extension = "com"
+	s.domains[domain.ID] = domain
+
+	price := func(amount float64) tlds.Price {
+		return tlds.Price{
+			Product:  domains.Amount{Currency: "USD", Price: amount + 1},
+			Reseller: domains.Amount{Currency: "EUR", Price: amount},
+		}
+	}
+	for _, tld := range []tlds.TLD{
+		{
+			Name: "com", Status: tlds.StatusActive, Type: "gTLD", MinPeriod: 1, MaxPeriod: 10,
+			IsDnssecAllowed: true, IsPrivateWhoisAllowed: true, IdnScripts: []string{"de", "es"},
+			IsTransferAuthCodeRequired: true,
+			Prices:                     &tlds.Prices{CreatePrice: price(9.75), RenewPrice: price(11), TransferPrice: price(8.5), RestorePrice: price(80)},
+		},
+		{
+			Name: "us", Status: tlds.StatusActive, Type: "ccTLD", Description: "United States", MinPeriod: 1, MaxPeriod: 10,
+			IsDnssecAllowed: true, IsTransferAuthCodeRequired: true, MinNameservers: 2, MaxNameservers: 13,
+			RequiredAdditionalData: []string{"nexus_category", "application_purpose"},
+			Prices:                 &tlds.Prices{CreatePrice: price(7.25)},
+		},
+		{
+			Name: "de", Status: tlds.StatusActive, Type: "ccTLD", MinPeriod: 1, MaxPeriod: 1,
+			IsDnssecAllowed: true, IdnScripts: []string{"de"}, IsTransferAuthCodeRequired: true,
+		},
+		{Name: "example", Status: "INA", Type: "gTLD", MinPeriod: 1, MaxPeriod: 1},
+	} {
+		s.tlds[tld.Name] = tld
+	}
+
+	server := httptest.NewServer(s)
+	t.Cleanup(server.Close)
+	s.url = server.URL
+	return s
+}
+
+// config returns a provider block for the server that allows billable
+// operations, followed by config.
+func (s *stubServer) config(config string) string {
+	return s.providerBlock(`"production"`) + config
+}
+
+// configWithoutBillable returns a provider block for the server that does not
+// allow billable operations, followed by config.
+func (s *stubServer) configWithoutBillable(config string) string {
+	return s.providerBlock("null") + config
+}
+
+func (s *stubServer) providerBlock(allowBillableOperations string) string {
+	return fmt.Sprintf(`
+provider "openprovider" {
+  base_url                  = %q
+  token                     = "test-token"
+  max_retries               = 0
+  allow_billable_operations = %s
+}
+`, s.url, allowBillableOperations)
+}
+
+// domain returns a copy of the named domain, if it exists.
+func (s *stubServer) domain(name string) (domains.Domain, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if domain := s.domainByName(name); domain != nil {
+		return *domain, true
+	}
+	return domains.Domain{}, false
+}
+
+// updateDomain changes the named domain outside Terraform.
+func (s *stubServer) updateDomain(name string, update func(*domains.Domain)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	update(s.domainByName(name))
+}
+
+// addPremium makes the named domain a premium domain.
+func (s *stubServer) addPremium(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.premium[name] = true
+}
+
+// sentAdditionalData returns the additional data last sent for the named domain.
+func (s *stubServer) sentAdditionalData(name string) *domains.AdditionalData {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.additionalData[name]
+}
+
+// queueStatuses makes the named domain report statuses on successive reads, as a
+// pending transfer would. The last status sticks.
+func (s *stubServer) queueStatuses(name string, statuses ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.statuses[name] = statuses
+}
+
+// holdOwnerChanges leaves requested owner changes pending until
+// confirmOwnerChanges, as if the registrants had not confirmed them yet.
+func (s *stubServer) holdOwnerChanges() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ownerChangesHeld = true
+}
+
+// confirmOwnerChanges completes the owner changes held by holdOwnerChanges.
+func (s *stubServer) confirmOwnerChanges() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for id, owner := range s.pendingOwners {
+		s.domains[id].OwnerHandle = owner
+	}
+	clear(s.pendingOwners)
+	s.ownerChangesHeld = false
+}
+
+// addZone adds a zone with records outside Terraform.
+func (s *stubServer) addZone(name string, records ...dns.Record) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	label, extension, _ := strings.Cut(name, ".")
+	s.zones[name] = &dns.Zone{ID: len(s.zones) + 1, Name: label, Extension: extension, Type: dns.ZoneTypeMaster, Provider: dns.ZoneProviderStandard}
+	s.records[name] = records
+}
+
+// zone returns a copy of the named zone, if it exists.
+func (s *stubServer) zone(name string) (dns.Zone, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	zone, ok := s.zones[name]
+	if !ok {
+		return dns.Zone{}, false
+	}
+	return *zone, true
+}
+
+// holdSettings accepts premium DNS and DNSSEC changes without applying them
+// yet, as OpenProvider does while it provisions them.
+func (s *stubServer) holdSettings() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.settingsHeld = true
+}
+
+// updateZone changes the named zone outside Terraform.
+func (s *stubServer) updateZone(name string, update func(*dns.Zone)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	update(s.zones[name])
+}
+
+// setRecords replaces the records of a zone outside Terraform.
+func (s *stubServer) setRecords(zoneName string, records ...dns.Record) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records[zoneName] = records
+}
+
+// zoneRecords returns a copy of the records of a zone.
+func (s *stubServer) zoneRecords(zoneName string) []dns.Record {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]dns.Record(nil), s.records[zoneName]...)
+}
+
+// countRequests returns how many requests matched "METHOD /path".
+func (s *stubServer) countRequests(request string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n := 0
+	for _, r := range s.requests {
+		if r == request {
+			n++
+		}
+	}
+	return n
+}
+
+func (s *stubServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = append(s.requests, r.Method+" "+r.URL.Path)
+
+	if rest, ok := strings.CutPrefix(r.URL.Path, "/v1beta/domains"); ok {
+		s.serveDomains(w, r, strings.Trim(rest, "/"))
+	} else if rest, ok := strings.CutPrefix(r.URL.Path, "/v1beta/dns/zones"); ok {
+		s.serveZones(w, r, strings.Trim(rest, "/"))
+	} else if rest, ok := strings.CutPrefix(r.URL.Path, "/v1beta/tlds"); ok && r.Method == http.MethodGet {
+		s.serveTLDs(w, r, strings.Trim(rest, "/"))
+	} else if r.URL.Path == "/v1beta/ssl/orders/123" && r.Method == http.MethodGet {
+		writeStubData(w, map[string]any{
+			"id":                 123,
+			"product_id":         18,
+			"common_name":        "example.com",
+			"brand_name":         "Sectigo",
+			"status":             "ACT",
+			"order_date":         "2026-01-01 00:00:00",
+			"active_date":        "2026-01-02 00:00:00",
+			"expiration_date":    "2027-01-02 00:00:00",
+			"autorenew":          "on",
+			"owner_handle":       "XX123456-XX",
+			"additional_domains": []string{"www.example.com"},
+		})
+	} else {
+		writeStubError(w, http.StatusNotFound, 404, "unknown endpoint")
+	}
+}
+
+// domainByName returns the named domain, or nil if it does not exist.
+func (s *stubServer) domainByName(name string) *domains.Domain {
+	for _, domain := range s.domains {
+		if domain.Domain.Name+"."+domain.Domain.Extension == name {
+			return domain
+		}
+	}
+	return nil
+}
+
+// changeOwner applies or, after holdOwnerChanges, holds an owner change.
+func (s *stubServer) changeOwner(domain *domains.Domain, owner string) {
+	if s.ownerChangesHeld {
+		s.pendingOwners[domain.ID] = owner
+		return
+	}
+	domain.OwnerHandle = owner
+}
+
+func (s *stubServer) serveDomains(w http.ResponseWriter, r *http.Request, rest string) {
+	switch {
+	case rest == "" && r.Method == http.MethodGet:
+		query := r.URL.Query()
+		results := []domains.Domain{}
+		for _, domain := range s.domains {
+			if name := query.Get("domain_name_pattern"); name != "" && name != domain.Domain.Name {
+				continue
+			}
+			if extension := query.Get("extension"); extension != "" && extension != domain.Domain.Extension {
+				continue
+			}
+			results = append(results, *domain)
+		}
+		writeStubData(w, map[string]any{"results": results, "total": len(results)})
+
+	case (rest == "" || rest == "transfer") && r.Method == http.MethodPost:
+		// Registrations and transfers carry the same domain and owner fields
+		var req domains.CreateDomainRequest
+		if !decodeStubRequest(w, r, &req) {
+			return
+		}
+		name := req.Domain.Name + "." + req.Domain.Extension
+		// Like the API, premium registrations need an accepted fee covering the price
+		if rest == "" && s.premium[name] && req.AcceptPremiumFee < s.price.Price.Reseller.Price {
+			writeStubError(w, http.StatusBadRequest, 399, "Premium fee not accepted")
+			return
+		}
+		domain := &domains.Domain{
+			ID:             s.nextDomainID,
+			OwnerHandle:    req.OwnerHandle,
+			AdminHandle:    req.AdminHandle,
+			TechHandle:     req.TechHandle,
+			BillingHandle:  req.BillingHandle,
+			Autorenew:      req.Autorenew,
+			Status:         domains.StatusActive,
+			ExpirationDate: "2027-03-01 10:00:00",
+			Nameservers:    numberNameservers(req.Nameservers),
+		}
+		s.nextDomainID++
+		domain.Domain.Name = req.Domain.Name
+		domain.Domain.Extension = req.Domain.Extension
+		if rest == "transfer" {
+			// Like the API, pending transfers apply privacy once they complete
+			domain.Status = domains.StatusRequested
+			if req.IsPrivateWhois != nil {
+				s.transferWhois[domain.ID] = *req.IsPrivateWhois
+			}
+		} else if req.IsPrivateWhois != nil {
+			domain.IsPrivateWhois = *req.IsPrivateWhois
+		}
+		s.additionalData[name] = req.AdditionalData
+		s.domains[domain.ID] = domain
+		writeStubData(w, domain)
+
+	case rest == "check" && r.Method == http.MethodPost:
+		var req domains.CheckDomainRequest
+		if !decodeStubRequest(w, r, &req) {
+			return
+		}
+		results := []domains.CheckResult{}
+		for _, d := range req.Domains {
+			result := domains.CheckResult{Domain: d.Name + "." + d.Extension, Status: domains.CheckStatusFree}
+			if s.domainByName(result.Domain) != nil {
+				result.Status = domains.CheckStatusActive
+				result.Reason = "Domain exists"
+			}
+			result.IsPremium = s.premium[result.Domain]
+			if req.WithPrice {
+				result.Price = &s.price.Price
+			}
+			results = append(results, result)
+		}
+		writeStubData(w, map[string]any{"results": results})
+
+	case rest == "trade" && r.Method == http.MethodPost:
+		var req domains.TradeDomainRequest
+		if !decodeStubRequest(w, r, &req) {
+			return
+		}
+		domain := s.domainByName(req.Domain.Name + "." + req.Domain.Extension)
+		if domain == nil {
+			writeStubError(w, http.StatusNotFound, 320, "Domain not found")
+			return
+		}
+		s.changeOwner(domain, req.OwnerHandle)
+		writeStubData(w, domain)
+
+	case rest == "prices" && r.Method == http.MethodGet:
+		writeStubData(w, s.price)
+
+	default:
+		s.serveDomain(w, r, rest)
+	}
+}
+
+// serveDomain serves the endpoints of a single domain, addressed by ID.
+func (s *stubServer) serveDomain(w http.ResponseWriter, r *http.Request, rest string) {
+	idPart, action, _ := strings.Cut(rest, "/")
+	id, err := strconv.Atoi(idPart)
+	if err != nil {
+		writeStubError(w, http.StatusNotFound, 404, "unknown endpoint")
+		return
+	}
+	domain, exists := s.domains[id]
+	if !exists {
+		writeStubError(w, http.StatusNotFound, 320, "Domain not found")
+		return
+	}
+	name := domain.Domain.Name + "." + domain.Domain.Extension
+
+	switch {
+	case action == "" && r.Method == http.MethodGet:
+		if queued := s.statuses[name]; len(queued) > 0 {
+			domain.Status = queued[0]
+			if len(queued) > 1 {
+				s.statuses[name] = queued[1:]
+			}
+		}
+		if private, pending := s.transferWhois[id]; pending && domain.Status == domains.StatusActive {
+			domain.IsPrivateWhois = private
+			delete(s.transferWhois, id)
+		}
+		writeStubData(w, domain)
+
+	case action == "" && r.Method == http.MethodPut:
+		var req domains.UpdateDomainRequest
+		if !decodeStubRequest(w, r, &req) {
+			return
+		}
+		if req.OwnerHandle != "" {
+			s.changeOwner(domain, req.OwnerHandle)
+		}
+		if req.Autorenew != "" {
+			domain.Autorenew = req.Autorenew
+		}
+		if req.Nameservers != nil {
+			domain.Nameservers = numberNameservers(req.Nameservers)
+		}
+		if req.IsLocked != nil {
+			domain.IsLocked = *req.IsLocked
+		}
+		// Like the API, pending transfers do not apply privacy yet
+		if req.IsPrivateWhois != nil && domain.Status != domains.StatusRequested {
+			domain.IsPrivateWhois = *req.IsPrivateWhois
+		}
+		if req.AdditionalData != nil {
+			s.additionalData[name] = req.AdditionalData
+		}
+		writeStubData(w, domain)
+
+	case action == "" && r.Method == http.MethodDelete:
+		delete(s.domains, id)
+		writeStubData(w, map[string]any{"success": true})
+
+	case action == "authcode" && r.Method == http.MethodGet:
+		writeStubData(w, domains.AuthCode{AuthCode: domain.AuthCode, Type: "internal"})
+
+	case action == "authcode/reset" && r.Method == http.MethodPost:
+		domain.AuthCode = fmt.Sprintf("reset-%d", len(s.requests))
+		writeStubData(w, domains.AuthCode{AuthCode: domain.AuthCode, Type: "internal"})
+
+	case action == "renew" && r.Method == http.MethodPost:
+		var req domains.RenewDomainRequest
+		if !decodeStubRequest(w, r, &req) {
+			return
+		}
+		year, _ := strconv.Atoi(domain.ExpirationDate[:4])
+		domain.ExpirationDate = fmt.Sprintf("%04d%s", year+req.Period, domain.ExpirationDate[4:])
+		writeStubData(w, map[string]any{"status": domains.StatusActive})
+
+	default:
+		writeStubError(w, http.StatusNotFound, 404, "unknown endpoint")
+	}
+}
+
+func (s *stubServer) serveZones(w http.ResponseWriter, r *http.Request, rest string) {
+	name, action, _ := strings.Cut(rest, "/")
+
+	if name == "" && r.Method == http.MethodPost {
+		var req dns.CreateZoneRequest
+		if !decodeStubRequest(w, r, &req) {
+			return
+		}
+		name = req.Domain.Name + "." + req.Domain.Extension
+		if _, exists := s.zones[name]; exists {
+			writeStubError(w, http.StatusBadRequest, 817, "Zone already exists")
+			return
+		}
+		zone := &dns.Zone{
+			ID:               len(s.zones) + 1,
+			Name:             req.Domain.Name,
+			Extension:        req.Domain.Extension,
+			Type:             req.Type,
+			IP:               req.MasterIP,
+			Provider:         dns.ZoneProviderStandard,
+			Active:           true,
+			CreationDate:     "2026-01-01 00:00:00",
+			ModificationDate: "2026-01-01 00:00:00",
+		}
+		if !s.settingsHeld {
+			zone.Provider = req.Provider
+			zone.Secured = req.Secured
+		}
+		s.zones[name] = zone
+		s.records[name] = append([]dns.Record(nil), req.Records...)
+		writeStubData(w, map[string]any{"success": true})
+		return
+	}
+
+	zone, exists := s.zones[name]
+	if !exists {
+		writeStubError(w, http.StatusNotFound, 871, "Zone not found")
+		return
+	}
+
+	switch {
+	case action == "" && r.Method == http.MethodGet:
+		writeStubData(w, zone)
+
+	case action == "" && r.Method == http.MethodPut:
+		var req dns.UpdateZoneRequest
+		if !decodeStubRequest(w, r, &req) {
+			return
+		}
+		if req.MasterIP != nil {
+			zone.IP = *req.MasterIP
+		}
+		if req.Provider != nil && !s.settingsHeld {
+			zone.Provider = *req.Provider
+		}
+		if req.Secured != nil && !s.settingsHeld {
+			zone.Secured = *req.Secured
+		}
+		if req.Records != nil {
+			records, err := applyRecordUpdates(s.records[name], *req.Records)
+			if err != nil {
+				writeStubError(w, http.StatusBadRequest, 400, err.Error())
+				return
+			}
+			s.records[name] = records
+		}
+		zone.ModificationDate = "2026-01-02 00:00:00"
+		writeStubData(w, map[string]any{"success": true})
+
+	case action == "" && r.Method == http.MethodDelete:
+		delete(s.zones, name)
+		delete(s.records, name)
+		writeStubData(w, map[string]any{"success": true})
+
+	case action == "records" && r.Method == http.MethodGet:
+		records := s.records[name]
+		writeStubData(w, map[string]any{"results": records, "total": len(records)})
+
+	case action == "records" && r.Method == http.MethodPost:
+		var req dns.CreateRecordRequest
+		if !decodeStubRequest(w, r, &req) {
+			return
+		}
+		record := dns.Record{
+			Name:             req.Name,
+			Type:             req.Type,
+			Value:            req.Value,
+			TTL:              req.TTL,
+			Priority:         req.Priority,
+			CreationDate:     "2026-01-01 00:00:00",
+			ModificationDate: "2026-01-01 00:00:00",
+		}
+		records, err := applyRecordUpdates(s.records[name], dns.RecordUpdates{Add: []dns.Record{record}})
+		if err != nil {
+			writeStubError(w, http.StatusBadRequest, 400, err.Error())
+			return
+		}
+		s.records[name] = records
+		writeStubData(w, record)
+
+	default:
+		writeStubError(w, http.StatusNotFound, 404, "unknown endpoint")
+	}
+}
+
+func (s *stubServer) serveTLDs(w http.ResponseWriter, r *http.Request, rest string) {
+	query := r.URL.Query()
+
+	// priced drops the prices unless they were requested
+	priced := func(tld tlds.TLD) tlds.TLD {
+		if query.Get("with_price") != "true" {
+			tld.Prices = nil
+		}
+		return tld
+	}
+
+	if rest != "" {
+		tld, exists := s.tlds[rest]
+		if !exists {
+			writeStubError(w, http.StatusNotFound, 404, "TLD not found")
+			return
+		}
+		writeStubData(w, priced(tld))
+		return
+	}
+
+	results := []tlds.TLD{}
+	for _, tld := range s.tlds {
+		if pattern := query.Get("name_pattern"); pattern != "" {
+			if matched, _ := path.Match(pattern, tld.Name); !matched {
+				continue
+			}
+		}
+		if status := query.Get("status"); status != "" && status != tld.Status {
+			continue
+		}
+		results = append(results, priced(tld))
+	}
+	writeStubData(w, map[string]any{"results": results, "total": len(results)})
+}
+
+// applyRecordUpdates applies a change set to records the way the API does, failing
+// on additions of existing records and on removals or updates of missing ones.
+func applyRecordUpdates(records []dns.Record, updates dns.RecordUpdates) ([]dns.Record, error) {
+	if updates.Replace != nil {
+		records = append([]dns.Record(nil), updates.Replace...)
+	}
+
+	index := func(record dns.Record) int {
+		for i, r := range records {
+			if r.Name == record.Name && r.Type == record.Type && r.Value == record.Value && r.Priority == record.Priority {
+				return i
+			}
+		}
+		return -1
+	}
+
+	for _, record := range updates.Remove {
+		i := index(record)
+		if i < 0 {
+			return nil, fmt.Errorf("record %s %s %s does not exist", record.Name, record.Type, record.Value)
+		}
+		records = append(records[:i], records[i+1:]...)
+	}
+	for _, update := range updates.Update {
+		i := index(update.OriginalRecord)
+		if i < 0 {
+			return nil, fmt.Errorf("record %s %s %s does not exist", update.OriginalRecord.Name, update.OriginalRecord.Type, update.OriginalRecord.Value)
+		}
+		records[i] = update.Record
+	}
+	for _, record := range updates.Add {
+		if index(record) >= 0 {
+			return nil, fmt.Errorf("record %s %s %s already exists", record.Name, record.Type, record.Value)
+		}
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// numberNameservers numbers nameservers without a sequence number, as the API does.
+func numberNameservers(nameservers []domains.Nameserver) []domains.Nameserver {
+	numbered := make([]domains.Nameserver, len(nameservers))
+	for i, ns := range nameservers {
+		if ns.SeqNr == 0 {
+			ns.SeqNr = i + 1
+		}
+		numbered[i] = ns
+	}
+	return numbered
+}
+
+// decodeStubRequest decodes the JSON request body into v, writing an error
+// response and returning false if it is malformed.
+func decodeStubRequest(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeStubError(w, http.StatusBadRequest, 400, err.Error())
+		return false
+	}
+	return true
+}
+
+// writeStubData writes a successful OpenProvider response envelope.
+func writeStubData(w http.ResponseWriter, data any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{"code": 0, "data": data})
+}
+
+// writeStubError writes a failed OpenProvider response envelope.
+func writeStubError(w http.ResponseWriter, status, code int, desc string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = fmt.Fprintf(w, `{"code": %d, "desc": %q}`, code, desc)
+}